@@ -0,0 +1,237 @@
+// Package archive implements the newline-delimited JSON archive format used
+// by Client.DumpSchemas and Client.RestoreSchemas, so CLI tools can build or
+// consume dumps without a live registry server.
+//
+// An archive is a single header line, one schema record per line, and a
+// trailer line carrying a SHA-256 digest of everything that came before it
+// so a reader can verify the archive wasn't truncated or corrupted in transit.
+package archive
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+)
+
+// FormatVersion is the current archive format version.
+const FormatVersion = 1
+
+// Header is the first line of an archive.
+type Header struct {
+	Kind           string    `json:"kind"`
+	ArchiveVersion int       `json:"archive_version"`
+	Source         string    `json:"source"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Record is a single schema entry in an archive.
+type Record struct {
+	Kind              string          `json:"kind"`
+	SchemaID          string          `json:"schema_id"`
+	Namespace         string          `json:"namespace"`
+	Name              string          `json:"name"`
+	Version           string          `json:"version"`
+	Format            string          `json:"format"`
+	Content           string          `json:"content"`
+	Metadata          json.RawMessage `json:"metadata,omitempty"`
+	CompatibilityMode string          `json:"compatibility_mode,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
+
+// Trailer is the final line of an archive, carrying a digest of everything
+// written before it.
+type Trailer struct {
+	Kind        string `json:"kind"`
+	SHA256      string `json:"sha256"`
+	RecordCount int    `json:"record_count"`
+}
+
+const (
+	kindHeader  = "header"
+	kindSchema  = "schema"
+	kindTrailer = "trailer"
+)
+
+// Writer writes an archive to an underlying io.Writer, maintaining a running
+// SHA-256 digest over every byte written and emitting a verifying trailer on
+// Close.
+type Writer struct {
+	w      io.Writer
+	digest hash.Hash
+	count  int
+	closed bool
+}
+
+// NewWriter creates a Writer and immediately writes the archive header.
+// source should identify where the dump came from (e.g. the registry's base URL).
+func NewWriter(w io.Writer, source string) (*Writer, error) {
+	aw := &Writer{w: w, digest: sha256.New()}
+
+	header := Header{
+		Kind:           kindHeader,
+		ArchiveVersion: FormatVersion,
+		Source:         source,
+		CreatedAt:      time.Now(),
+	}
+	if err := aw.writeLine(header); err != nil {
+		return nil, fmt.Errorf("failed to write archive header: %w", err)
+	}
+	return aw, nil
+}
+
+// WriteRecord appends a single schema record to the archive.
+func (aw *Writer) WriteRecord(rec Record) error {
+	if aw.closed {
+		return fmt.Errorf("archive writer is closed")
+	}
+	rec.Kind = kindSchema
+	if err := aw.writeLine(rec); err != nil {
+		return fmt.Errorf("failed to write archive record: %w", err)
+	}
+	aw.count++
+	return nil
+}
+
+// Close writes the trailer (digest and record count) and marks the writer
+// closed. It does not close the underlying io.Writer.
+func (aw *Writer) Close() error {
+	if aw.closed {
+		return nil
+	}
+	aw.closed = true
+
+	trailer := Trailer{
+		Kind:        kindTrailer,
+		SHA256:      hex.EncodeToString(aw.digest.Sum(nil)),
+		RecordCount: aw.count,
+	}
+
+	line, err := json.Marshal(trailer)
+	if err != nil {
+		return fmt.Errorf("failed to marshal archive trailer: %w", err)
+	}
+	_, err = fmt.Fprintf(aw.w, "%s\n", line)
+	return err
+}
+
+// writeLine marshals v, writes it as a single line to w, and folds the bytes
+// written (including the trailing newline) into the running digest.
+func (aw *Writer) writeLine(v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if _, err := aw.digest.Write(line); err != nil {
+		return err
+	}
+	_, err = aw.w.Write(line)
+	return err
+}
+
+// Reader reads an archive written by Writer, verifying its trailing digest
+// once the stream is fully consumed.
+type Reader struct {
+	scanner   *bufio.Scanner
+	digest    hash.Hash
+	header    Header
+	count     int
+	verified  bool
+	verifyErr error
+}
+
+// NewReader creates a Reader and reads the archive header.
+func NewReader(r io.Reader) (*Reader, Header, error) {
+	ar := &Reader{
+		scanner: bufio.NewScanner(r),
+		digest:  sha256.New(),
+	}
+	ar.scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	if !ar.scanner.Scan() {
+		if err := ar.scanner.Err(); err != nil {
+			return nil, Header{}, fmt.Errorf("failed to read archive header: %w", err)
+		}
+		return nil, Header{}, fmt.Errorf("archive is empty")
+	}
+
+	line := append([]byte(nil), ar.scanner.Bytes()...)
+	ar.digest.Write(line)
+	ar.digest.Write([]byte("\n"))
+
+	var header Header
+	if err := json.Unmarshal(line, &header); err != nil {
+		return nil, Header{}, fmt.Errorf("failed to unmarshal archive header: %w", err)
+	}
+	if header.Kind != kindHeader {
+		return nil, Header{}, fmt.Errorf("expected header line, got kind %q", header.Kind)
+	}
+	ar.header = header
+
+	return ar, header, nil
+}
+
+// Next returns the next schema record. It returns io.EOF once the trailer
+// has been consumed; call Verified/Err afterward to check digest integrity.
+func (ar *Reader) Next() (Record, error) {
+	if !ar.scanner.Scan() {
+		if err := ar.scanner.Err(); err != nil {
+			return Record{}, fmt.Errorf("failed to read archive: %w", err)
+		}
+		return Record{}, fmt.Errorf("archive ended without a trailer")
+	}
+
+	line := append([]byte(nil), ar.scanner.Bytes()...)
+
+	var probe struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(line, &probe); err != nil {
+		return Record{}, fmt.Errorf("failed to unmarshal archive line: %w", err)
+	}
+
+	if probe.Kind == kindTrailer {
+		var trailer Trailer
+		if err := json.Unmarshal(line, &trailer); err != nil {
+			return Record{}, fmt.Errorf("failed to unmarshal archive trailer: %w", err)
+		}
+		sum := hex.EncodeToString(ar.digest.Sum(nil))
+		if sum != trailer.SHA256 {
+			ar.verifyErr = fmt.Errorf("archive digest mismatch: got %s, trailer says %s", sum, trailer.SHA256)
+		} else if ar.count != trailer.RecordCount {
+			ar.verifyErr = fmt.Errorf("archive record count mismatch: read %d, trailer says %d", ar.count, trailer.RecordCount)
+		} else {
+			ar.verified = true
+		}
+		return Record{}, io.EOF
+	}
+
+	ar.digest.Write(line)
+	ar.digest.Write([]byte("\n"))
+
+	var rec Record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return Record{}, fmt.Errorf("failed to unmarshal archive record: %w", err)
+	}
+	ar.count++
+
+	return rec, nil
+}
+
+// Verified reports whether the trailer's digest and record count matched
+// what was actually read. It is only meaningful after Next has returned io.EOF.
+func (ar *Reader) Verified() bool {
+	return ar.verified
+}
+
+// Err returns the verification error, if any, after Next has returned io.EOF.
+func (ar *Reader) Err() error {
+	return ar.verifyErr
+}
@@ -0,0 +1,96 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, "http://source.example")
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+
+	const n = 10000
+	for i := 0; i < n; i++ {
+		rec := Record{
+			SchemaID:  fmt.Sprintf("schema-%d", i),
+			Namespace: "ns",
+			Name:      fmt.Sprintf("Schema%d", i),
+			Version:   "1.0.0",
+			Format:    "json_schema",
+			Content:   `{"type":"object"}`,
+		}
+		if err := w.WriteRecord(rec); err != nil {
+			t.Fatalf("WriteRecord failed at %d: %v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	r, header, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	if header.Source != "http://source.example" {
+		t.Errorf("unexpected source: %s", header.Source)
+	}
+
+	count := 0
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next failed at %d: %v", count, err)
+		}
+		if rec.SchemaID != fmt.Sprintf("schema-%d", count) {
+			t.Fatalf("out of order record at %d: %s", count, rec.SchemaID)
+		}
+		count++
+	}
+
+	if count != n {
+		t.Fatalf("expected %d records, got %d", n, count)
+	}
+	if !r.Verified() {
+		t.Errorf("expected archive to verify, got error: %v", r.Err())
+	}
+}
+
+func TestDigestMismatchDetected(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, "src")
+	_ = w.WriteRecord(Record{SchemaID: "a", Namespace: "ns", Name: "A", Version: "1.0.0", Format: "json_schema", Content: "{}"})
+	_ = w.Close()
+
+	corrupted := strings.Replace(buf.String(), `"schema_id":"a"`, `"schema_id":"tampered"`, 1)
+
+	r, _, err := NewReader(strings.NewReader(corrupted))
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	for {
+		_, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+	}
+
+	if r.Verified() {
+		t.Error("expected verification to fail after tampering")
+	}
+	if r.Err() == nil {
+		t.Error("expected a digest mismatch error")
+	}
+}
@@ -0,0 +1,475 @@
+package schema_registry
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials supplies authentication for outgoing requests. Implementations
+// must be safe for concurrent use.
+type Credentials interface {
+	// ApplyTo attaches credentials to req (typically by setting a header).
+	ApplyTo(ctx context.Context, req *http.Request) error
+	// Refresh forces the credentials to renew themselves (e.g. fetch a new
+	// token). Implementations for which refreshing makes no sense (static
+	// keys, basic auth) may treat this as a no-op.
+	Refresh(ctx context.Context) error
+	// Expiry returns when the current credentials expire. A zero time means
+	// they never expire.
+	Expiry() time.Time
+}
+
+// RequestSigner signs outgoing requests, e.g. with AWS SigV4 or an HMAC
+// scheme. It runs after Credentials.ApplyTo, so it can incorporate headers
+// credentials already set.
+type RequestSigner interface {
+	Sign(ctx context.Context, req *http.Request, body []byte) error
+}
+
+// StaticAPIKey sends a fixed API key as a bearer token on every request. It
+// is the implementation ClientConfig.APIKey is wrapped in for backwards
+// compatibility.
+type StaticAPIKey struct {
+	Key string
+}
+
+// ApplyTo sets the Authorization header to "Bearer <Key>".
+func (s StaticAPIKey) ApplyTo(_ context.Context, req *http.Request) error {
+	if s.Key != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Key)
+	}
+	return nil
+}
+
+// Refresh is a no-op; a static key never changes.
+func (s StaticAPIKey) Refresh(_ context.Context) error { return nil }
+
+// Expiry always returns the zero time; a static key never expires.
+func (s StaticAPIKey) Expiry() time.Time { return time.Time{} }
+
+// BearerToken sends a fixed, externally-managed bearer token. Unlike
+// StaticAPIKey it allows the token to be swapped at runtime via SetToken,
+// which is useful when a caller refreshes tokens out-of-band.
+type BearerToken struct {
+	mu    sync.RWMutex
+	token string
+}
+
+// NewBearerToken creates a BearerToken credential with the given initial token.
+func NewBearerToken(token string) *BearerToken {
+	return &BearerToken{token: token}
+}
+
+// SetToken updates the token used for subsequent requests.
+func (b *BearerToken) SetToken(token string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.token = token
+}
+
+// ApplyTo sets the Authorization header to "Bearer <token>".
+func (b *BearerToken) ApplyTo(_ context.Context, req *http.Request) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.token != "" {
+		req.Header.Set("Authorization", "Bearer "+b.token)
+	}
+	return nil
+}
+
+// Refresh is a no-op; callers update the token via SetToken.
+func (b *BearerToken) Refresh(_ context.Context) error { return nil }
+
+// Expiry always returns the zero time; BearerToken has no built-in expiry.
+func (b *BearerToken) Expiry() time.Time { return time.Time{} }
+
+// BasicAuth sends HTTP Basic authentication credentials.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// ApplyTo sets HTTP Basic auth credentials on req.
+func (b BasicAuth) ApplyTo(_ context.Context, req *http.Request) error {
+	req.SetBasicAuth(b.Username, b.Password)
+	return nil
+}
+
+// Refresh is a no-op; basic auth credentials don't expire.
+func (b BasicAuth) Refresh(_ context.Context) error { return nil }
+
+// Expiry always returns the zero time; basic auth credentials never expire.
+func (b BasicAuth) Expiry() time.Time { return time.Time{} }
+
+// HMACAuth signs outgoing requests with an HMAC-SHA256 canonical-request
+// signature keyed by a shared secret, similar in spirit to AWS SigV4 and to
+// the request-signing schemes supported by confluent-kafka-go's Schema
+// Registry client. It is a RequestSigner (see ClientConfig.Signer), applied
+// after Credentials.ApplyTo.
+type HMACAuth struct {
+	// KeyID identifies which secret signed the request; sent alongside the
+	// signature so the server can look up the matching secret.
+	KeyID string
+	// Secret is the shared HMAC signing key.
+	Secret string
+}
+
+// Sign computes the canonical request (method, URI, timestamp, and a SHA-256
+// hash of the body) and signs it with HMAC-SHA256, setting the X-SR-Date and
+// X-SR-Signature headers.
+func (h HMACAuth) Sign(_ context.Context, req *http.Request, body []byte) error {
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	req.Header.Set("X-SR-Date", timestamp)
+
+	mac := hmac.New(sha256.New, []byte(h.Secret))
+	mac.Write([]byte(hmacCanonicalRequest(req.Method, req.URL.RequestURI(), body, timestamp)))
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	req.Header.Set("X-SR-Signature", h.KeyID+":"+signature)
+	return nil
+}
+
+// hmacCanonicalRequest builds the string HMACAuth signs: the method, request
+// URI, timestamp, and a hex-encoded SHA-256 hash of the body, newline-joined.
+func hmacCanonicalRequest(method, uri string, body []byte, timestamp string) string {
+	bodyHash := sha256.Sum256(body)
+	return strings.Join([]string{
+		method,
+		uri,
+		timestamp,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+}
+
+// oauth2TokenResponse is the subset of an OAuth2 token endpoint response
+// OAuth2ClientCredentials understands.
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// OAuth2ClientCredentials implements the OAuth2 client-credentials grant,
+// fetching and caching an access token from TokenURL and refreshing it
+// automatically before it expires. Concurrent refreshes are single-flighted
+// so only one request hits the token endpoint at a time.
+type OAuth2ClientCredentials struct {
+	// TokenURL is the OAuth2 token endpoint.
+	TokenURL string
+	// ClientID is the OAuth2 client ID.
+	ClientID string
+	// ClientSecret is the OAuth2 client secret.
+	ClientSecret string
+	// Scopes are the requested OAuth2 scopes.
+	Scopes []string
+	// HTTPClient is used to call TokenURL. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Leeway refreshes the token this long before it actually expires
+	// (default: 30 seconds).
+	Leeway time.Duration
+
+	mu          sync.Mutex
+	token       string
+	expiry      time.Time
+	inflight    chan struct{}
+	inflightErr error
+}
+
+// ApplyTo sets the Authorization header to "Bearer <token>", refreshing the
+// token first if it is missing or about to expire.
+func (o *OAuth2ClientCredentials) ApplyTo(ctx context.Context, req *http.Request) error {
+	if o.needsRefresh() {
+		if err := o.Refresh(ctx); err != nil {
+			return err
+		}
+	}
+
+	o.mu.Lock()
+	token := o.token
+	o.mu.Unlock()
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Expiry returns when the current cached token expires.
+func (o *OAuth2ClientCredentials) Expiry() time.Time {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.expiry
+}
+
+func (o *OAuth2ClientCredentials) needsRefresh() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.token == "" {
+		return true
+	}
+	leeway := o.Leeway
+	if leeway <= 0 {
+		leeway = 30 * time.Second
+	}
+	return !o.expiry.IsZero() && time.Now().After(o.expiry.Add(-leeway))
+}
+
+// Refresh fetches a new access token from TokenURL. Concurrent callers
+// single-flight onto the same in-flight request.
+func (o *OAuth2ClientCredentials) Refresh(ctx context.Context) error {
+	o.mu.Lock()
+	if o.inflight != nil {
+		ch := o.inflight
+		o.mu.Unlock()
+		<-ch
+		o.mu.Lock()
+		err := o.inflightErr
+		o.mu.Unlock()
+		return err
+	}
+
+	ch := make(chan struct{})
+	o.inflight = ch
+	o.mu.Unlock()
+
+	err := o.doRefresh(ctx)
+
+	o.mu.Lock()
+	o.inflightErr = err
+	o.inflight = nil
+	close(ch)
+	o.mu.Unlock()
+
+	return err
+}
+
+func (o *OAuth2ClientCredentials) doRefresh(ctx context.Context) error {
+	httpClient := o.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", o.ClientID)
+	form.Set("client_secret", o.ClientSecret)
+	if len(o.Scopes) > 0 {
+		form.Set("scope", strings.Join(o.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read token response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var tokenResp oauth2TokenResponse
+	if err := json.Unmarshal(respBody, &tokenResp); err != nil {
+		return fmt.Errorf("failed to unmarshal token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return fmt.Errorf("token endpoint response did not include an access_token")
+	}
+
+	o.mu.Lock()
+	o.token = tokenResp.AccessToken
+	if tokenResp.ExpiresIn > 0 {
+		o.expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	} else {
+		o.expiry = time.Time{}
+	}
+	o.mu.Unlock()
+
+	return nil
+}
+
+// closer is implemented by Credentials that need to stop background work
+// when the client closes, such as RenewingTokenAuth's renewal goroutine.
+// Client.Close checks for this via a type assertion, the same extension-
+// point pattern CacheStats uses for statsProvider.
+type closer interface {
+	Close() error
+}
+
+// RenewingTokenAuth periodically refreshes a bearer token from a
+// user-supplied callback and transparently renews it in the background
+// before it expires, similar to Vault's LifetimeWatcher. A renewal failure
+// is swallowed - RenewBehaviorIgnoreErrors-style - so an intermittent
+// outage on the token source doesn't tear down the client; the last
+// known-good token keeps being used until the next attempt succeeds.
+type RenewingTokenAuth struct {
+	// Fetch returns a fresh token and its remaining lifetime. A zero ttl
+	// means the token doesn't expire, in which case Fetch is retried
+	// periodically anyway in case a future call starts returning one.
+	Fetch func(ctx context.Context) (token string, ttl time.Duration, err error)
+	// Leeway renews the token this long before it actually expires
+	// (default: 30 seconds).
+	Leeway time.Duration
+	// RetryInterval controls how soon a failed renewal, or a renewal of a
+	// non-expiring token, is retried (default: 30 seconds).
+	RetryInterval time.Duration
+
+	mu          sync.Mutex
+	token       string
+	expiry      time.Time
+	inflight    chan struct{}
+	inflightErr error
+
+	startOnce sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+	stopOnce  sync.Once
+}
+
+// ApplyTo sets the Authorization header to "Bearer <token>", fetching an
+// initial token synchronously if none has been fetched yet. Once a token is
+// in hand, it starts the background renewal loop, which schedules its first
+// proactive renewal from that token's actual expiry - so it never races the
+// initial fetch.
+func (r *RenewingTokenAuth) ApplyTo(ctx context.Context, req *http.Request) error {
+	if r.currentToken() == "" {
+		if err := r.renewOnce(ctx); err != nil {
+			return err
+		}
+	}
+	r.startOnce.Do(func() { r.start() })
+
+	req.Header.Set("Authorization", "Bearer "+r.currentToken())
+	return nil
+}
+
+// Refresh forces an immediate renewal, single-flighted with any concurrent
+// or in-progress background renewal.
+func (r *RenewingTokenAuth) Refresh(ctx context.Context) error {
+	return r.renewOnce(ctx)
+}
+
+// Expiry returns when the current token expires. A zero time means it
+// either never expires or no token has been fetched yet.
+func (r *RenewingTokenAuth) Expiry() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.expiry
+}
+
+// Close stops the background renewal loop. It is safe to call more than
+// once and safe to call even if ApplyTo/Refresh were never invoked.
+func (r *RenewingTokenAuth) Close() error {
+	r.stopOnce.Do(func() {
+		if r.stop != nil {
+			close(r.stop)
+			<-r.done
+		}
+	})
+	return nil
+}
+
+func (r *RenewingTokenAuth) currentToken() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.token
+}
+
+func (r *RenewingTokenAuth) start() {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.renewLoop()
+}
+
+func (r *RenewingTokenAuth) renewLoop() {
+	defer close(r.done)
+
+	for {
+		wait := r.nextInterval()
+		select {
+		case <-r.stop:
+			return
+		case <-time.After(wait):
+		}
+
+		// RenewBehaviorIgnoreErrors: a failed background renewal is
+		// swallowed so the client keeps using its last known-good token.
+		_ = r.renewOnce(context.Background())
+	}
+}
+
+func (r *RenewingTokenAuth) nextInterval() time.Duration {
+	retryInterval := r.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = 30 * time.Second
+	}
+
+	expiry := r.Expiry()
+	if expiry.IsZero() {
+		return retryInterval
+	}
+
+	leeway := r.Leeway
+	if leeway <= 0 {
+		leeway = 30 * time.Second
+	}
+	if wait := time.Until(expiry.Add(-leeway)); wait > 0 {
+		return wait
+	}
+	return retryInterval
+}
+
+// renewOnce fetches a new token, single-flighting concurrent callers (the
+// background loop and an explicit ApplyTo/Refresh call can race) onto the
+// same underlying Fetch call.
+func (r *RenewingTokenAuth) renewOnce(ctx context.Context) error {
+	r.mu.Lock()
+	if r.inflight != nil {
+		ch := r.inflight
+		r.mu.Unlock()
+		<-ch
+		r.mu.Lock()
+		err := r.inflightErr
+		r.mu.Unlock()
+		return err
+	}
+
+	ch := make(chan struct{})
+	r.inflight = ch
+	r.mu.Unlock()
+
+	token, ttl, err := r.Fetch(ctx)
+
+	r.mu.Lock()
+	if err == nil {
+		r.token = token
+		if ttl > 0 {
+			r.expiry = time.Now().Add(ttl)
+		} else {
+			r.expiry = time.Time{}
+		}
+	}
+	r.inflightErr = err
+	r.inflight = nil
+	close(ch)
+	r.mu.Unlock()
+
+	return err
+}
@@ -0,0 +1,254 @@
+package schema_registry
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOAuth2ClientCredentialsRefreshesOnExpiry(t *testing.T) {
+	var tokenCalls int32
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&tokenCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":1}`, n)
+	}))
+	defer tokenServer.Close()
+
+	creds := &OAuth2ClientCredentials{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Leeway:       900 * time.Millisecond,
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err := creds.ApplyTo(context.Background(), req); err != nil {
+		t.Fatalf("ApplyTo failed: %v", err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer token-1" {
+		t.Errorf("expected Bearer token-1, got %q", got)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	req2, _ := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err := creds.ApplyTo(context.Background(), req2); err != nil {
+		t.Fatalf("ApplyTo failed: %v", err)
+	}
+	if got := req2.Header.Get("Authorization"); got != "Bearer token-2" {
+		t.Errorf("expected refreshed token-2, got %q", got)
+	}
+
+	if atomic.LoadInt32(&tokenCalls) != 2 {
+		t.Errorf("expected exactly 2 token calls, got %d", tokenCalls)
+	}
+}
+
+func TestOAuth2ClientCredentialsSingleFlightsRefresh(t *testing.T) {
+	var tokenCalls int32
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenCalls, 1)
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"shared-token","expires_in":60}`)
+	}))
+	defer tokenServer.Close()
+
+	creds := &OAuth2ClientCredentials{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := creds.Refresh(context.Background()); err != nil {
+				t.Errorf("Refresh failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&tokenCalls) != 1 {
+		t.Errorf("expected refresh to be single-flighted to 1 call, got %d", tokenCalls)
+	}
+}
+
+func TestClientRefreshesCredentialsOn401(t *testing.T) {
+	var refreshed int32
+	validToken := "fresh-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+validToken {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"status":"healthy"}`)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	creds := &refreshableToken{
+		token: "stale-token",
+		onRefresh: func() {
+			atomic.AddInt32(&refreshed, 1)
+		},
+		refreshedToken: validToken,
+	}
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, Credentials: creds})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	health, err := client.HealthCheck(context.Background())
+	if err != nil {
+		t.Fatalf("expected refresh-and-retry to succeed, got: %v", err)
+	}
+	if health.Status != "healthy" {
+		t.Errorf("unexpected status: %s", health.Status)
+	}
+	if atomic.LoadInt32(&refreshed) != 1 {
+		t.Errorf("expected exactly 1 refresh, got %d", refreshed)
+	}
+}
+
+// refreshableToken is a test Credentials implementation that starts stale
+// and becomes valid after exactly one Refresh call.
+type refreshableToken struct {
+	mu             sync.Mutex
+	token          string
+	refreshedToken string
+	onRefresh      func()
+}
+
+func (r *refreshableToken) ApplyTo(_ context.Context, req *http.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req.Header.Set("Authorization", "Bearer "+r.token)
+	return nil
+}
+
+func (r *refreshableToken) Refresh(_ context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.token = r.refreshedToken
+	if r.onRefresh != nil {
+		r.onRefresh()
+	}
+	return nil
+}
+
+func TestHMACAuthSignsRequestDeterministically(t *testing.T) {
+	auth := HMACAuth{KeyID: "key-1", Secret: "shh"}
+
+	req, _ := http.NewRequest(http.MethodPost, "http://example.invalid/api/v1/validate", nil)
+	if err := auth.Sign(context.Background(), req, []byte(`{"a":1}`)); err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+
+	if req.Header.Get("X-SR-Date") == "" {
+		t.Error("expected X-SR-Date header to be set")
+	}
+	sig := req.Header.Get("X-SR-Signature")
+	if !strings.HasPrefix(sig, "key-1:") {
+		t.Errorf("expected signature to be prefixed with key ID, got %q", sig)
+	}
+
+	otherReq, _ := http.NewRequest(http.MethodPost, "http://example.invalid/api/v1/validate", nil)
+	otherReq.Header.Set("X-SR-Date", req.Header.Get("X-SR-Date"))
+	mac := hmac.New(sha256.New, []byte(auth.Secret))
+	mac.Write([]byte(hmacCanonicalRequest(http.MethodPost, otherReq.URL.RequestURI(), []byte(`{"a":1}`), req.Header.Get("X-SR-Date"))))
+	want := "key-1:" + hex.EncodeToString(mac.Sum(nil))
+	if sig != want {
+		t.Errorf("signature = %q, want %q", sig, want)
+	}
+}
+
+func TestRenewingTokenAuthRenewsInBackground(t *testing.T) {
+	var calls int32
+
+	auth := &RenewingTokenAuth{
+		Fetch: func(ctx context.Context) (string, time.Duration, error) {
+			n := atomic.AddInt32(&calls, 1)
+			return fmt.Sprintf("token-%d", n), 300 * time.Millisecond, nil
+		},
+		Leeway: 100 * time.Millisecond,
+	}
+	defer auth.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err := auth.ApplyTo(context.Background(), req); err != nil {
+		t.Fatalf("ApplyTo failed: %v", err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer token-1" {
+		t.Errorf("expected Bearer token-1, got %q", got)
+	}
+
+	// The background loop renews at ttl-leeway (~200ms); wait long enough
+	// for exactly one more renewal but not two.
+	time.Sleep(250 * time.Millisecond)
+
+	req2, _ := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err := auth.ApplyTo(context.Background(), req2); err != nil {
+		t.Fatalf("ApplyTo failed: %v", err)
+	}
+	if got := req2.Header.Get("Authorization"); got != "Bearer token-2" {
+		t.Errorf("expected background renewal to have produced token-2, got %q", got)
+	}
+}
+
+func TestRenewingTokenAuthIgnoresRenewalFailures(t *testing.T) {
+	var calls int32
+
+	auth := &RenewingTokenAuth{
+		Fetch: func(ctx context.Context) (string, time.Duration, error) {
+			n := atomic.AddInt32(&calls, 1)
+			if n == 1 {
+				return "token-1", 100 * time.Millisecond, nil
+			}
+			return "", 0, fmt.Errorf("token source unavailable")
+		},
+		Leeway:        80 * time.Millisecond,
+		RetryInterval: 50 * time.Millisecond,
+	}
+	defer auth.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err := auth.ApplyTo(context.Background(), req); err != nil {
+		t.Fatalf("ApplyTo failed: %v", err)
+	}
+
+	// Every renewal after the first fails; the client must keep serving
+	// token-1 rather than tearing itself down.
+	time.Sleep(300 * time.Millisecond)
+
+	req2, _ := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err := auth.ApplyTo(context.Background(), req2); err != nil {
+		t.Fatalf("ApplyTo failed: %v", err)
+	}
+	if got := req2.Header.Get("Authorization"); got != "Bearer token-1" {
+		t.Errorf("expected the client to keep using the last known-good token after failed renewals, got %q", got)
+	}
+	if atomic.LoadInt32(&calls) < 2 {
+		t.Error("expected at least one background renewal attempt after the initial fetch")
+	}
+}
+
+func (r *refreshableToken) Expiry() time.Time { return time.Time{} }
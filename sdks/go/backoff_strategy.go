@@ -0,0 +1,102 @@
+package schema_registry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// BackoffStrategy computes the delay before the next retry attempt. attempt
+// is the 0-indexed retry attempt number; prev is the delay returned by the
+// previous call, which DecorrelatedJitter needs to carry its state across
+// calls. On the first call Backoff.Next passes zero, while Retry and
+// RetryWithResult pass RetryConfig.InitialBackoff.
+type BackoffStrategy interface {
+	NextDelay(attempt int, prev time.Duration) time.Duration
+}
+
+// ExponentialJitter is the original additive-jitter exponential backoff:
+// base*multiplier^attempt, capped at MaxBackoff, plus up to 25% additive
+// jitter if Jitter is set. It's the strategy RetryConfig falls back to when
+// Strategy is nil.
+type ExponentialJitter struct {
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+	Jitter            bool
+}
+
+// NextDelay implements BackoffStrategy.
+func (s ExponentialJitter) NextDelay(attempt int, prev time.Duration) time.Duration {
+	base := s.InitialBackoff
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+	multiplier := s.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 2.0
+	}
+	backoff := time.Duration(float64(base) * math.Pow(multiplier, float64(attempt)))
+	return calculateBackoff(backoff, s.MaxBackoff, s.Jitter)
+}
+
+// FullJitter samples the delay uniformly from [0, min(MaxBackoff,
+// InitialBackoff*2^attempt)), rather than adding jitter on top of the
+// exponential value. This avoids the correlated retry spikes additive
+// jitter still produces under load, at the cost of occasionally retrying
+// almost immediately.
+type FullJitter struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// NextDelay implements BackoffStrategy.
+func (s FullJitter) NextDelay(attempt int, prev time.Duration) time.Duration {
+	base := s.InitialBackoff
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+
+	capped := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if s.MaxBackoff > 0 && capped > s.MaxBackoff {
+		capped = s.MaxBackoff
+	}
+	if capped <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(capped)))
+}
+
+// DecorrelatedJitter samples the next delay from [InitialBackoff,
+// prev*3), capped at MaxBackoff, so each delay is correlated with the last
+// one rather than the attempt count - this spreads out retries from many
+// callers that started backing off at the same time better than
+// FullJitter does. prev must be threaded from call to call (see
+// Backoff.Next(), which does this automatically).
+type DecorrelatedJitter struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// NextDelay implements BackoffStrategy.
+func (s DecorrelatedJitter) NextDelay(attempt int, prev time.Duration) time.Duration {
+	base := s.InitialBackoff
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+	if prev <= 0 {
+		prev = base
+	}
+
+	span := prev*3 - base
+	if span <= 0 {
+		span = base
+	}
+
+	delay := time.Duration(rand.Int63n(int64(span))) + base
+	if s.MaxBackoff > 0 && delay > s.MaxBackoff {
+		delay = s.MaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,106 @@
+package schema_registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestExponentialJitterCapsAtMaxBackoff(t *testing.T) {
+	s := ExponentialJitter{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond, BackoffMultiplier: 2.0, Jitter: false}
+
+	if d := s.NextDelay(0, 0); d != 10*time.Millisecond {
+		t.Errorf("expected 10ms at attempt 0, got %v", d)
+	}
+	if d := s.NextDelay(10, 0); d != 50*time.Millisecond {
+		t.Errorf("expected the delay to be capped at MaxBackoff, got %v", d)
+	}
+}
+
+func TestFullJitterSamplesBetweenZeroAndCap(t *testing.T) {
+	s := FullJitter{InitialBackoff: 10 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt := 0; attempt < 5; attempt++ {
+		for i := 0; i < 50; i++ {
+			d := s.NextDelay(attempt, 0)
+			if d < 0 {
+				t.Fatalf("expected a non-negative delay, got %v", d)
+			}
+			cap := 10 * time.Millisecond << attempt
+			if d > cap {
+				t.Fatalf("expected delay %v to be below the uncapped bound %v at attempt %d", d, cap, attempt)
+			}
+		}
+	}
+}
+
+func TestFullJitterRespectsMaxBackoff(t *testing.T) {
+	s := FullJitter{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond}
+
+	for i := 0; i < 50; i++ {
+		if d := s.NextDelay(10, 0); d > 20*time.Millisecond {
+			t.Fatalf("expected delay to stay within MaxBackoff, got %v", d)
+		}
+	}
+}
+
+func TestDecorrelatedJitterStaysWithinBounds(t *testing.T) {
+	s := DecorrelatedJitter{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 200 * time.Millisecond}
+
+	prev := time.Duration(0)
+	for i := 0; i < 50; i++ {
+		d := s.NextDelay(i, prev)
+		if d < 10*time.Millisecond {
+			t.Fatalf("expected delay to be at least InitialBackoff, got %v", d)
+		}
+		if d > 200*time.Millisecond {
+			t.Fatalf("expected delay to stay within MaxBackoff, got %v", d)
+		}
+		prev = d
+	}
+}
+
+func TestBackoffNextDelegatesToStrategy(t *testing.T) {
+	b := NewBackoff(10*time.Millisecond, time.Second, false)
+	b.SetStrategy(FullJitter{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond})
+
+	for i := 0; i < 10; i++ {
+		if d := b.Next(); d > 20*time.Millisecond {
+			t.Fatalf("expected Next() to respect the configured strategy's cap, got %v", d)
+		}
+	}
+	if b.Attempt() != 10 {
+		t.Errorf("expected 10 attempts recorded, got %d", b.Attempt())
+	}
+
+	b.Reset()
+	if b.Attempt() != 0 {
+		t.Errorf("expected Reset to zero the attempt counter, got %d", b.Attempt())
+	}
+}
+
+func TestRetryHonorsConfiguredStrategy(t *testing.T) {
+	cfg := RetryConfig{
+		MaxAttempts:       1,
+		InitialBackoff:    time.Hour, // would block the test if the default math were used
+		MaxBackoff:        time.Hour,
+		BackoffMultiplier: 2.0,
+		RetryableFunc:     func(error) bool { return true },
+		Strategy:          FullJitter{InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond},
+	}
+
+	attempts := 0
+	start := time.Now()
+	_ = Retry(context.Background(), cfg, func() error {
+		attempts++
+		return errors.New("boom")
+	})
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Strategy to be honored instead of InitialBackoff, took %v", elapsed)
+	}
+}
@@ -30,6 +30,13 @@ type CacheStats struct {
 	Hits      uint64
 	Misses    uint64
 	Evictions uint64
+	// Coalesced is the number of GetSchema/GetSchemaByVersion calls that
+	// shared a result with an in-flight or cross-process fetch instead of
+	// triggering their own, preventing a cache-stampede.
+	Coalesced uint64
+	// RefreshConflicts is the number of times RefreshLock could not be
+	// acquired, so a fetch proceeded without cross-process coordination.
+	RefreshConflicts uint64
 }
 
 // cacheItem is the internal representation of a cache item.
@@ -162,6 +169,21 @@ func (c *Cache[K, V]) Stats() CacheStats {
 	}
 }
 
+// Range calls fn for each item in the cache, from most to least recently
+// used, stopping early if fn returns false. It exists so Cache satisfies the
+// CacheStore interface.
+func (c *Cache[K, V]) Range(fn func(key K, value V) bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for e := c.evictList.Front(); e != nil; e = e.Next() {
+		item := e.Value.(*cacheItem[K, V])
+		if !fn(item.key, item.value) {
+			return
+		}
+	}
+}
+
 // HitRate returns the cache hit rate (0.0 to 1.0).
 func (c *Cache[K, V]) HitRate() float64 {
 	stats := c.Stats()
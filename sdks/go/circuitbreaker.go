@@ -0,0 +1,148 @@
+package schema_registry
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitBreakerConfig configures the per-endpoint circuit breaker attached
+// to RetryConfig.CircuitBreaker. See circuitBreakerPool for the state
+// machine this drives.
+type CircuitBreakerConfig struct {
+	// FailureRatio is the fraction of requests (0 to 1) in the trailing
+	// MinRequests window that must fail before the circuit opens for an
+	// endpoint.
+	FailureRatio float64
+	// MinRequests is the minimum number of requests observed before
+	// FailureRatio is evaluated, so a single early failure can't trip the
+	// breaker.
+	MinRequests int
+	// Cooldown is how long the circuit stays open before a single probe
+	// request is let through (half-open).
+	Cooldown time.Duration
+}
+
+// circuitBreakerEntry tracks a single endpoint's trailing request/failure
+// window and open/half-open state. window is a ring buffer holding the last
+// len(window) outcomes (true = success); count is how many of those slots
+// are populated so far (caps at len(window)), and failures is the number of
+// false entries currently in the window - both kept current as push
+// overwrites the oldest slot, so FailureRatio is always evaluated over the
+// trailing window rather than the endpoint's entire history.
+type circuitBreakerEntry struct {
+	mu       sync.Mutex
+	window   []bool
+	next     int
+	count    int
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+// push records a single request outcome, evicting the oldest entry in the
+// window once it's full.
+func (e *circuitBreakerEntry) push(success bool) {
+	if e.count == len(e.window) {
+		if !e.window[e.next] {
+			e.failures--
+		}
+	} else {
+		e.count++
+	}
+	e.window[e.next] = success
+	if !success {
+		e.failures++
+	}
+	e.next = (e.next + 1) % len(e.window)
+}
+
+// reset clears the window, used when a probe succeeds and the endpoint gets
+// a clean slate.
+func (e *circuitBreakerEntry) reset() {
+	e.next = 0
+	e.count = 0
+	e.failures = 0
+}
+
+// circuitBreakerPool is a per-endpoint circuit breaker, lazily creating an
+// entry the first time a given endpoint is seen. It mirrors rateLimiterPool's
+// shape.
+type circuitBreakerPool struct {
+	mu      sync.Mutex
+	cfg     CircuitBreakerConfig
+	entries map[string]*circuitBreakerEntry
+}
+
+// newCircuitBreakerPool creates a circuit breaker pool from cfg.
+func newCircuitBreakerPool(cfg CircuitBreakerConfig) *circuitBreakerPool {
+	return &circuitBreakerPool{cfg: cfg, entries: make(map[string]*circuitBreakerEntry)}
+}
+
+// entry returns endpoint's tracking entry, creating it if necessary.
+func (p *circuitBreakerPool) entry(endpoint string) *circuitBreakerEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e, ok := p.entries[endpoint]
+	if !ok {
+		windowSize := p.cfg.MinRequests
+		if windowSize < 1 {
+			windowSize = 1
+		}
+		e = &circuitBreakerEntry{window: make([]bool, windowSize)}
+		p.entries[endpoint] = e
+	}
+	return e
+}
+
+// allow reports whether a request to endpoint may proceed. It returns false
+// while the circuit is open and Cooldown has not yet elapsed, and lets
+// exactly one half-open probe request through once it has.
+func (p *circuitBreakerPool) allow(endpoint string) bool {
+	e := p.entry(endpoint)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.open {
+		return true
+	}
+	if e.probing {
+		return false
+	}
+	if time.Since(e.openedAt) < p.cfg.Cooldown {
+		return false
+	}
+
+	e.probing = true
+	return true
+}
+
+// recordResult updates endpoint's counters after a request completes. A
+// half-open probe closes the circuit on success or re-opens it (resetting
+// the cooldown) on failure.
+func (p *circuitBreakerPool) recordResult(endpoint string, success bool) {
+	e := p.entry(endpoint)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.probing {
+		e.probing = false
+		if success {
+			e.open = false
+			e.reset()
+		} else {
+			e.openedAt = time.Now()
+		}
+		return
+	}
+
+	e.push(success)
+
+	if !e.open && p.cfg.MinRequests > 0 && e.count >= p.cfg.MinRequests {
+		if float64(e.failures)/float64(e.count) >= p.cfg.FailureRatio {
+			e.open = true
+			e.openedAt = time.Now()
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package schema_registry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerPoolTripsAfterFailureRatio(t *testing.T) {
+	p := newCircuitBreakerPool(CircuitBreakerConfig{FailureRatio: 0.5, MinRequests: 2, Cooldown: time.Hour})
+
+	if !p.allow("a") {
+		t.Fatal("expected the circuit to start closed")
+	}
+	p.recordResult("a", false)
+	if !p.allow("a") {
+		t.Fatal("expected the circuit to still be closed below MinRequests")
+	}
+	p.recordResult("a", false)
+
+	if p.allow("a") {
+		t.Error("expected the circuit to be open after 2/2 failures crossed FailureRatio")
+	}
+}
+
+func TestCircuitBreakerPoolUsesTrailingWindowNotCumulativeHistory(t *testing.T) {
+	p := newCircuitBreakerPool(CircuitBreakerConfig{FailureRatio: 0.5, MinRequests: 4, Cooldown: time.Hour})
+
+	for i := 0; i < 100; i++ {
+		p.recordResult("a", true)
+	}
+	if !p.allow("a") {
+		t.Fatal("expected the circuit to still be closed after only successes")
+	}
+
+	// A cumulative 100-success, 4-failure history has a failure ratio of
+	// ~4%, well under FailureRatio - if the breaker evaluated the
+	// endpoint's entire history, a sustained outage here would never trip
+	// it. A trailing window of the last MinRequests outcomes sees 4/4
+	// failures and must trip.
+	for i := 0; i < 4; i++ {
+		p.recordResult("a", false)
+	}
+
+	if p.allow("a") {
+		t.Error("expected a sustained outage to trip the breaker via the trailing window, despite a long prior run of successes")
+	}
+}
+
+func TestCircuitBreakerPoolProbesAfterCooldown(t *testing.T) {
+	p := newCircuitBreakerPool(CircuitBreakerConfig{FailureRatio: 0.5, MinRequests: 1, Cooldown: 10 * time.Millisecond})
+
+	p.allow("a")
+	p.recordResult("a", false)
+	if p.allow("a") {
+		t.Fatal("expected the circuit to be open immediately after tripping")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if !p.allow("a") {
+		t.Fatal("expected a single half-open probe to be let through after Cooldown")
+	}
+	if p.allow("a") {
+		t.Error("expected only one probe in flight at a time")
+	}
+
+	p.recordResult("a", true)
+	if !p.allow("a") {
+		t.Error("expected a successful probe to close the circuit")
+	}
+}
+
+func TestCircuitBreakerPoolReopensOnFailedProbe(t *testing.T) {
+	p := newCircuitBreakerPool(CircuitBreakerConfig{FailureRatio: 0.5, MinRequests: 1, Cooldown: 10 * time.Millisecond})
+
+	p.allow("a")
+	p.recordResult("a", false)
+	time.Sleep(20 * time.Millisecond)
+
+	if !p.allow("a") {
+		t.Fatal("expected a probe to be let through")
+	}
+	p.recordResult("a", false)
+
+	if p.allow("a") {
+		t.Error("expected a failed probe to re-open the circuit")
+	}
+}
+
+func TestClientCircuitBreakerShortCircuitsRequests(t *testing.T) {
+	var serverHits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverHits++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	retryConfig := RetryConfig{
+		MaxAttempts:   0,
+		RetryableFunc: IsRetryable,
+		CircuitBreaker: &CircuitBreakerConfig{
+			FailureRatio: 0.5,
+			MinRequests:  1,
+			Cooldown:     time.Hour,
+		},
+	}
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, RetryConfig: &retryConfig})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	if _, err := client.GetSchema(ctx, "id-1"); err == nil {
+		t.Fatal("expected an error from the failing server")
+	}
+
+	_, err = client.GetSchema(ctx, "id-1")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen after the circuit tripped, got %v", err)
+	}
+	if serverHits != 1 {
+		t.Errorf("expected the second call to be short-circuited without hitting the server, got %d hits", serverHits)
+	}
+}
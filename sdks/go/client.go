@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -19,14 +21,69 @@ import (
 type Client struct {
 	// baseURL is the base URL of the schema registry API.
 	baseURL string
-	// apiKey is the optional API key for authentication.
-	apiKey string
+	// credentials supplies authentication for outgoing requests.
+	credentials Credentials
+	// signer optionally signs outgoing requests after credentials are applied.
+	signer RequestSigner
 	// httpClient is the underlying HTTP client.
 	httpClient *http.Client
-	// cache is the thread-safe LRU cache for schema responses.
-	cache *Cache[string, *GetSchemaResponse]
+	// cache is the cache backend for schema responses. Its concrete type
+	// depends on ClientConfig.CacheBackend: an in-memory LRU by default, or a
+	// disk-backed or two-tier store when CacheDir is configured.
+	cache CacheStore[string, *GetSchemaResponse]
 	// retryConfig configures retry behavior.
 	retryConfig RetryConfig
+	// watchConfig configures the WatchSchemas streaming connection.
+	watchConfig WatchConfig
+	// endpoints is the multi-endpoint pool, set when ClientConfig.Endpoints
+	// is non-empty. Nil means the client operates against the single baseURL.
+	endpoints *endpointPool
+	// syncStop, when non-nil, signals the endpoint auto-sync goroutine to stop.
+	syncStop chan struct{}
+	// syncDone is closed once the endpoint auto-sync goroutine has exited.
+	syncDone chan struct{}
+	// latestRefresh tracks "latest" version lookups for background refresh,
+	// set when ClientConfig.CacheLatestTTL is positive.
+	latestRefresh *latestTracker
+	// latestRefreshStop, when non-nil, signals the latest-refresh goroutine to stop.
+	latestRefreshStop chan struct{}
+	// latestRefreshDone is closed once the latest-refresh goroutine has exited.
+	latestRefreshDone chan struct{}
+	// latestRefreshStopOnce guards against double-stopping the latest-refresh
+	// goroutine if Close is ever called more than once.
+	latestRefreshStopOnce sync.Once
+	// singleflight coalesces concurrent in-process fetches for the same
+	// cache key to prevent a cache-stampede.
+	singleflight *callGroup
+	// refreshLock coordinates cache refresh across processes sharing a
+	// registry. Defaults to an in-process mutex; see RefreshLock.
+	refreshLock RefreshLock
+	// stats tracks stampede-coordination counters not visible to the cache
+	// backend itself.
+	stats clientStats
+	// localStore, set when ClientConfig.OfflineSchemaDirs is non-empty, serves
+	// GetSchema, GetSchemaByVersion, ListVersions, and ValidateData from a
+	// local schema bundle when the registry can't be reached.
+	localStore *LocalStore
+	// rateLimiter paces outgoing requests per endpoint, set when
+	// ClientConfig.RateLimit is non-nil.
+	rateLimiter *rateLimiterPool
+	// circuitBreaker short-circuits requests to an endpoint that has crossed
+	// its failure ratio, set when RetryConfig.CircuitBreaker is non-nil.
+	circuitBreaker *circuitBreakerPool
+	// requestStats tracks client-side rate-limiting counters.
+	requestStats requestStatsCounters
+	// formatCheckers validates JSON Schema "format" keywords during offline
+	// validation and is sent to the server as the "formats" field on
+	// RegisterSchema, so it knows which custom formats the client enforces.
+	// Seeded with built-in checkers; see RegisterFormatChecker.
+	formatCheckers *formatCheckerRegistry
+	// refreshMarks tracks, per namespace, the newest UpdatedAt timestamp seen
+	// by RefreshSchemas, so repeated calls only fetch schemas updated since
+	// the last one.
+	refreshMarks map[string]time.Time
+	// refreshMarksMu protects refreshMarks.
+	refreshMarksMu sync.Mutex
 	// mu protects concurrent access to the client.
 	mu sync.RWMutex
 	// closed indicates if the client has been closed.
@@ -38,7 +95,17 @@ type ClientConfig struct {
 	// BaseURL is the base URL of the schema registry (required).
 	BaseURL string
 	// APIKey is the optional API key for authentication.
+	//
+	// Deprecated: set Credentials to a StaticAPIKey (or another Credentials
+	// implementation) instead. APIKey is still honored - it is wrapped in a
+	// StaticAPIKey - but Credentials takes precedence if both are set.
 	APIKey string
+	// Credentials supplies authentication for outgoing requests. If nil and
+	// APIKey is set, it defaults to StaticAPIKey{Key: APIKey}.
+	Credentials Credentials
+	// Signer optionally signs outgoing requests (e.g. AWS SigV4 or HMAC)
+	// after Credentials have been applied.
+	Signer RequestSigner
 	// HTTPClient is an optional custom HTTP client. If nil, a default client is used.
 	HTTPClient *http.Client
 	// Timeout is the request timeout (default: 30 seconds).
@@ -47,10 +114,61 @@ type ClientConfig struct {
 	CacheTTL time.Duration
 	// CacheMaxSize is the maximum number of cached items (default: 1000).
 	CacheMaxSize int
+	// CacheLatestTTL, if positive, enables background refresh of "latest"
+	// version lookups (see GetSchemaByVersion) at this interval, so repeated
+	// callers get automatic refresh instead of a synchronous fetch on every
+	// cache miss. This matches CacheLatestTtlSecs in Confluent's Go client
+	// and avoids thundering-herd fetches when many goroutines resolve the
+	// current version simultaneously. Zero disables background refresh.
+	CacheLatestTTL time.Duration
 	// RetryConfig configures retry behavior. If nil, default config is used.
 	RetryConfig *RetryConfig
 	// EnableCache enables caching (default: true).
 	EnableCache bool
+	// WatchConfig configures the WatchSchemas streaming connection. If nil,
+	// default config is used.
+	WatchConfig *WatchConfig
+	// Endpoints lists the cluster member endpoints to load-balance and fail
+	// over across. If set, it takes precedence over BaseURL for request
+	// routing; requests routed through Endpoints do not fall back to BaseURL.
+	// BaseURL is still required and validated, and DumpSchemas and
+	// WatchSchemas always connect to BaseURL.
+	Endpoints []string
+	// SyncInterval controls how often the client refreshes Endpoints from the
+	// server's own cluster membership view (default: 30s; a negative value
+	// disables auto-sync). Only used when Endpoints is set.
+	SyncInterval time.Duration
+	// CacheDir, if set, persists cached schema responses to this directory so
+	// they survive process restarts (e.g. for CLIs, CI jobs, or serverless
+	// functions sharing a warm cache). Requires CacheBackend to be
+	// CacheBackendDisk or CacheBackendTwoTier.
+	CacheDir string
+	// CacheBackend selects the cache implementation (default:
+	// CacheBackendMemory). CacheBackendDisk and CacheBackendTwoTier require
+	// CacheDir to be set.
+	CacheBackend CacheBackend
+	// RefreshLock coordinates cache refresh across a fleet of clients
+	// sharing a registry, preventing a thundering herd of duplicate fetches
+	// on a cache miss. If nil, an in-process mutex is used, which only
+	// coordinates goroutines within this client.
+	RefreshLock RefreshLock
+	// OfflineSchemaDirs lists directories or tarballs (.tar/.tar.gz/.tgz) of
+	// schema JSON files, each organized as namespace/name/version.json, used
+	// to serve GetSchema, GetSchemaByVersion, ListVersions, and ValidateData
+	// without any HTTP calls. The client runs in hybrid mode: it tries the
+	// registry first and falls back to the local bundle only when the
+	// registry request fails with a server error (an HTTP 5xx response or a
+	// transport failure), a timeout, or a cancellation. This is useful for
+	// air-gapped CI, sandboxed builds, or as a fallback when HealthCheck
+	// shows the registry is down.
+	OfflineSchemaDirs []string
+	// RateLimit, if set, paces outgoing requests per endpoint with a
+	// client-side token-bucket limiter, so a burst of calls doesn't hammer
+	// the server into 429s in the first place. If nil, requests are sent
+	// unpaced and rely solely on server-side rate limiting (see RateLimitError).
+	// RequestsPerSecond must be greater than 0; NewClient rejects a zero or
+	// negative value rather than constructing a limiter that never refills.
+	RateLimit *RateLimitConfig
 }
 
 // NewClient creates a new schema registry client with the given configuration.
@@ -58,6 +176,9 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 	if cfg.BaseURL == "" {
 		return nil, fmt.Errorf("base URL is required")
 	}
+	if (cfg.CacheBackend == CacheBackendDisk || cfg.CacheBackend == CacheBackendTwoTier) && cfg.CacheDir == "" {
+		return nil, fmt.Errorf("cache dir is required for disk and two-tier cache backends")
+	}
 
 	// Validate and normalize base URL
 	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
@@ -95,19 +216,95 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 		retryConfig = *cfg.RetryConfig
 	}
 
+	// Create watch config if not provided
+	watchConfig := DefaultWatchConfig()
+	if cfg.WatchConfig != nil {
+		watchConfig = *cfg.WatchConfig
+	}
+
 	// Create cache
-	var cache *Cache[string, *GetSchemaResponse]
+	var cache CacheStore[string, *GetSchemaResponse]
 	if cfg.EnableCache {
-		cache = NewCache[string, *GetSchemaResponse](cfg.CacheMaxSize, cfg.CacheTTL, nil)
+		switch cfg.CacheBackend {
+		case CacheBackendDisk:
+			diskCache, err := NewDiskStore[string, *GetSchemaResponse](cfg.CacheDir, cfg.CacheTTL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create disk cache: %w", err)
+			}
+			cache = diskCache
+		case CacheBackendTwoTier:
+			diskCache, err := NewDiskStore[string, *GetSchemaResponse](cfg.CacheDir, cfg.CacheTTL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create disk cache: %w", err)
+			}
+			cache = NewTwoTierStore[string, *GetSchemaResponse](cfg.CacheMaxSize, cfg.CacheTTL, diskCache)
+		default:
+			cache = NewCache[string, *GetSchemaResponse](cfg.CacheMaxSize, cfg.CacheTTL, nil)
+		}
+	}
+
+	// Resolve credentials, falling back to the deprecated APIKey field
+	credentials := cfg.Credentials
+	if credentials == nil && cfg.APIKey != "" {
+		credentials = StaticAPIKey{Key: cfg.APIKey}
+	}
+
+	refreshLock := cfg.RefreshLock
+	if refreshLock == nil {
+		refreshLock = newMutexRefreshLock()
+	}
+
+	var localStore *LocalStore
+	if len(cfg.OfflineSchemaDirs) > 0 {
+		var err error
+		localStore, err = NewLocalStore(cfg.OfflineSchemaDirs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rateLimiter *rateLimiterPool
+	if cfg.RateLimit != nil {
+		if cfg.RateLimit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("rate limit requests per second must be greater than 0")
+		}
+		rateLimiter = newRateLimiterPool(*cfg.RateLimit)
+	}
+
+	var circuitBreaker *circuitBreakerPool
+	if retryConfig.CircuitBreaker != nil {
+		circuitBreaker = newCircuitBreakerPool(*retryConfig.CircuitBreaker)
+	}
+
+	client := &Client{
+		baseURL:        baseURL,
+		credentials:    credentials,
+		signer:         cfg.Signer,
+		httpClient:     httpClient,
+		cache:          cache,
+		retryConfig:    retryConfig,
+		watchConfig:    watchConfig,
+		singleflight:   newCallGroup(),
+		refreshLock:    refreshLock,
+		localStore:     localStore,
+		rateLimiter:    rateLimiter,
+		circuitBreaker: circuitBreaker,
+		formatCheckers: newFormatCheckerRegistry(),
 	}
 
-	return &Client{
-		baseURL:     baseURL,
-		apiKey:      cfg.APIKey,
-		httpClient:  httpClient,
-		cache:       cache,
-		retryConfig: retryConfig,
-	}, nil
+	if len(cfg.Endpoints) > 0 {
+		client.endpoints = newEndpointPool(cfg.Endpoints)
+
+		syncInterval := cfg.SyncInterval
+		if syncInterval == 0 {
+			syncInterval = DefaultSyncInterval
+		}
+		client.startEndpointSync(syncInterval)
+	}
+
+	client.startLatestRefresh(cfg.CacheLatestTTL)
+
+	return client, nil
 }
 
 // Close closes the client and releases resources.
@@ -121,6 +318,18 @@ func (c *Client) Close() error {
 
 	c.closed = true
 
+	// Stop the endpoint auto-sync goroutine, if running
+	c.stopEndpointSync()
+
+	// Stop the latest-refresh goroutine, if running
+	c.stopLatestRefresh()
+
+	// Stop any background renewal goroutine the credentials started (e.g.
+	// RenewingTokenAuth)
+	if cl, ok := c.credentials.(closer); ok {
+		cl.Close()
+	}
+
 	// Clear cache
 	if c.cache != nil {
 		c.cache.Clear()
@@ -132,6 +341,15 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// registerSchemaRequest is the wire shape for RegisterSchema: the schema
+// itself plus the names of any custom format checkers the client has
+// registered, so the server can enforce the same "format" validation the
+// client does locally (see RegisterFormatChecker).
+type registerSchemaRequest struct {
+	*Schema
+	Formats []string `json:"formats,omitempty"`
+}
+
 // RegisterSchema registers a new schema in the registry.
 func (c *Client) RegisterSchema(ctx context.Context, schema *Schema) (*RegisterSchemaResponse, error) {
 	if err := c.checkClosed(); err != nil {
@@ -143,8 +361,17 @@ func (c *Client) RegisterSchema(ctx context.Context, schema *Schema) (*RegisterS
 		return nil, &ValidationError{Errors: []string{err.Error()}}
 	}
 
-	// Marshal schema
-	body, err := marshalJSON(schema)
+	// Resolve references transitively so a dangling or typo'd import is
+	// caught here, rather than surfacing as an opaque server error.
+	if len(schema.References) > 0 {
+		if err := c.resolveReferences(ctx, schema.References, make(map[string]bool)); err != nil {
+			return nil, fmt.Errorf("failed to resolve schema references: %w", err)
+		}
+	}
+
+	// Marshal schema, including the custom format checkers this client
+	// enforces so the server can apply the same "format" validation.
+	body, err := marshalJSON(registerSchemaRequest{Schema: schema, Formats: c.formatCheckers.names()})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal schema: %w", err)
 	}
@@ -174,29 +401,23 @@ func (c *Client) GetSchema(ctx context.Context, schemaID string) (*GetSchemaResp
 		return nil, err
 	}
 
-	// Check cache first
 	cacheKey := fmt.Sprintf("schema:%s", schemaID)
-	if c.cache != nil {
-		if cached, ok := c.cache.Get(cacheKey); ok {
-			return cached, nil
+	resp, err := c.fetchWithCoalescing(ctx, cacheKey, func() (*GetSchemaResponse, error) {
+		resp := &GetSchemaResponse{}
+		err := Retry(ctx, c.retryConfig, func() error {
+			return c.doRequest(ctx, "GET", fmt.Sprintf("/api/v1/schemas/%s", schemaID), nil, resp)
+		})
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	// Make request with retry
-	resp := &GetSchemaResponse{}
-	err := Retry(ctx, c.retryConfig, func() error {
-		return c.doRequest(ctx, "GET", fmt.Sprintf("/api/v1/schemas/%s", schemaID), nil, resp)
+		return resp, nil
 	})
-
 	if err != nil {
+		if local, ok := c.offlineFallbackByID(err, schemaID); ok {
+			return local, nil
+		}
 		return nil, err
 	}
-
-	// Cache the result
-	if c.cache != nil {
-		c.cache.Set(cacheKey, resp)
-	}
-
 	return resp, nil
 }
 
@@ -206,28 +427,28 @@ func (c *Client) GetSchemaByVersion(ctx context.Context, namespace, name, versio
 		return nil, err
 	}
 
-	// Check cache first
 	cacheKey := fmt.Sprintf("schema:%s.%s:%s", namespace, name, version)
-	if c.cache != nil {
-		if cached, ok := c.cache.Get(cacheKey); ok {
-			return cached, nil
-		}
-	}
-
-	// Make request with retry
 	path := fmt.Sprintf("/api/v1/schemas/%s/%s/versions/%s", namespace, name, version)
-	resp := &GetSchemaResponse{}
-	err := Retry(ctx, c.retryConfig, func() error {
-		return c.doRequest(ctx, "GET", path, nil, resp)
-	})
 
+	resp, err := c.fetchWithCoalescing(ctx, cacheKey, func() (*GetSchemaResponse, error) {
+		resp := &GetSchemaResponse{}
+		err := Retry(ctx, c.retryConfig, func() error {
+			return c.doRequest(ctx, "GET", path, nil, resp)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	})
 	if err != nil {
+		if local, ok := c.offlineFallbackByVersion(err, namespace, name, version); ok {
+			return local, nil
+		}
 		return nil, err
 	}
 
-	// Cache the result
-	if c.cache != nil {
-		c.cache.Set(cacheKey, resp)
+	if version == "latest" && c.latestRefresh != nil {
+		c.latestRefresh.track(cacheKey, namespace, name, path)
 	}
 
 	return resp, nil
@@ -267,11 +488,22 @@ func (c *Client) ValidateData(ctx context.Context, schemaID string, data interfa
 	err = Retry(ctx, c.retryConfig, func() error {
 		return c.doRequest(ctx, "POST", "/api/v1/validate", body, resp)
 	})
+	if err != nil {
+		if local, ok, verr := c.offlineFallbackValidate(ctx, err, schemaID, dataStr); verr != nil {
+			return nil, verr
+		} else if ok {
+			return local, nil
+		}
+		return nil, err
+	}
 
-	return resp, err
+	return resp, nil
 }
 
-// CheckCompatibility checks if a new schema is compatible with an existing schema.
+// CheckCompatibility checks if a new schema is compatible with an existing
+// schema. If the existing schema has References, each one is checked for
+// compatibility in turn under the same mode, and any incompatibilities
+// found are merged into the result, prefixed with the reference's name.
 func (c *Client) CheckCompatibility(ctx context.Context, schemaID, newSchemaContent string, mode CompatibilityMode) (*CompatibilityResult, error) {
 	if err := c.checkClosed(); err != nil {
 		return nil, err
@@ -293,8 +525,76 @@ func (c *Client) CheckCompatibility(ctx context.Context, schemaID, newSchemaCont
 	err = Retry(ctx, c.retryConfig, func() error {
 		return c.doRequest(ctx, "POST", "/api/v1/compatibility/check", body, resp)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return resp, err
+	if existing, ferr := c.GetSchema(ctx, schemaID); ferr == nil && len(existing.References) > 0 {
+		if rerr := c.checkReferenceCompatibility(ctx, existing.References, mode, resp); rerr != nil {
+			return nil, rerr
+		}
+	}
+
+	return resp, nil
+}
+
+// resolveReferences walks refs transitively, fetching each referenced
+// schema (and that schema's own references, recursively) so a dangling or
+// typo'd import is caught before a registration request is sent. seen
+// de-duplicates references visited more than once in the same graph.
+func (c *Client) resolveReferences(ctx context.Context, refs []SchemaReference, seen map[string]bool) error {
+	for _, ref := range refs {
+		key := ref.Subject + "@" + ref.Version
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		namespace, name, err := SubjectIdentity(ref.Subject)
+		if err != nil {
+			return fmt.Errorf("reference %q: %w", ref.Name, err)
+		}
+
+		resolved, err := c.GetSchemaByVersion(ctx, namespace, name, ref.Version)
+		if err != nil {
+			return fmt.Errorf("failed to resolve reference %q (%s@%s): %w", ref.Name, ref.Subject, ref.Version, err)
+		}
+
+		if err := c.resolveReferences(ctx, resolved.References, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkReferenceCompatibility recursively checks each reference in refs for
+// self-compatibility under mode, merging any incompatibilities it finds
+// into result.
+func (c *Client) checkReferenceCompatibility(ctx context.Context, refs []SchemaReference, mode CompatibilityMode, result *CompatibilityResult) error {
+	for _, ref := range refs {
+		namespace, name, err := SubjectIdentity(ref.Subject)
+		if err != nil {
+			return fmt.Errorf("reference %q: %w", ref.Name, err)
+		}
+
+		resolved, err := c.GetSchemaByVersion(ctx, namespace, name, ref.Version)
+		if err != nil {
+			return fmt.Errorf("failed to resolve reference %q (%s@%s): %w", ref.Name, ref.Subject, ref.Version, err)
+		}
+
+		refResult, err := c.CheckCompatibility(ctx, resolved.SchemaID, resolved.Content, mode)
+		if err != nil {
+			return fmt.Errorf("failed to check compatibility of reference %q: %w", ref.Name, err)
+		}
+
+		if !refResult.IsCompatible {
+			result.IsCompatible = false
+			for _, inc := range refResult.Incompatibilities {
+				result.Incompatibilities = append(result.Incompatibilities, fmt.Sprintf("%s: %s", ref.Name, inc))
+			}
+		}
+	}
+	return nil
 }
 
 // SearchSchemas searches for schemas using full-text search.
@@ -333,8 +633,14 @@ func (c *Client) ListVersions(ctx context.Context, namespace, name string) ([]*S
 	err := Retry(ctx, c.retryConfig, func() error {
 		return c.doRequest(ctx, "GET", path, nil, &resp)
 	})
+	if err != nil {
+		if local, ok := c.offlineFallbackVersions(err, namespace, name); ok {
+			return local, nil
+		}
+		return nil, err
+	}
 
-	return resp, err
+	return resp, nil
 }
 
 // DeleteSchema deletes a schema by ID.
@@ -382,19 +688,146 @@ func (c *Client) ClearCache() {
 	}
 }
 
-// CacheStats returns cache statistics.
+// RegisterFormatChecker installs checker as the validator for the JSON
+// Schema "format" keyword named name, replacing any existing one
+// (including a built-in). It applies both to this client's offline
+// validation fallback (see ClientConfig.OfflineSchemaDirs) and to future
+// RegisterSchema calls, which send the names of all registered format
+// checkers to the server so it enforces the same formats.
+func (c *Client) RegisterFormatChecker(name string, checker FormatChecker) {
+	c.formatCheckers.register(name, checker)
+}
+
+// statsProvider is implemented by cache backends that track hit/miss/eviction
+// counters. DiskStore does not, since every lookup round-trips the
+// filesystem rather than tracking in-process statistics.
+type statsProvider interface {
+	Stats() CacheStats
+}
+
+// CacheStats returns cache statistics, including the Coalesced and
+// RefreshConflicts counters tracked by the stampede-coordination layer (see
+// RefreshLock). Backends that don't track hit/miss/eviction statistics
+// (such as a disk-only DiskStore) leave those fields zero.
 func (c *Client) CacheStats() CacheStats {
+	var backend CacheStats
 	if c.cache != nil {
-		return c.cache.Stats()
+		if sp, ok := c.cache.(statsProvider); ok {
+			backend = sp.Stats()
+		}
+	}
+	return CacheStats{
+		Hits:             backend.Hits,
+		Misses:           backend.Misses,
+		Evictions:        backend.Evictions,
+		Coalesced:        atomic.LoadUint64(&c.stats.coalesced),
+		RefreshConflicts: atomic.LoadUint64(&c.stats.refreshConflicts),
+	}
+}
+
+// RequestStats returns client-side request-pacing counters: how many
+// requests the rate limiter deferred before sending, and how many still
+// came back with a 429 (see ClientConfig.RateLimit).
+func (c *Client) RequestStats() RequestStats {
+	return RequestStats{
+		Throttled: atomic.LoadUint64(&c.requestStats.throttled),
+		Deferred:  atomic.LoadUint64(&c.requestStats.deferred),
 	}
-	return CacheStats{}
 }
 
 // doRequest performs an HTTP request and unmarshals the response.
 // result must be a pointer to the target struct or nil for no response body.
+//
+// On a 401 response it invokes Credentials.Refresh exactly once and retries
+// the request a single time - this is independent of, and happens before,
+// the caller's RetryConfig-driven retry loop.
 func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, result interface{}) error {
+	err := c.doRequestEndpoints(ctx, method, path, body, result)
+	if err == nil || c.credentials == nil || !errors.Is(err, ErrAuthentication) {
+		return err
+	}
+
+	if refreshErr := c.credentials.Refresh(ctx); refreshErr != nil {
+		return err
+	}
+
+	return c.doRequestEndpoints(ctx, method, path, body, result)
+}
+
+// doRequestEndpoints performs an HTTP request and unmarshals the response.
+//
+// When the client has a multi-endpoint pool, it transparently fails over to
+// the next healthy endpoint on a server error or timeout before returning to
+// the caller - Retry only sees a failure once every endpoint has been tried.
+func (c *Client) doRequestEndpoints(ctx context.Context, method, path string, body []byte, result interface{}) error {
+	if c.endpoints == nil {
+		return c.doRequestTo(ctx, c.baseURL, method, path, body, result)
+	}
+
+	order := c.endpoints.pickOrder()
+	var lastErr error
+	for _, endpoint := range order {
+		err := c.doRequestTo(ctx, endpoint, method, path, body, result)
+		if err == nil {
+			c.endpoints.markSuccess(endpoint)
+			return nil
+		}
+
+		lastErr = err
+		c.endpoints.markFailure(endpoint, err)
+
+		if ctx.Err() != nil {
+			return wrapCtxErr(ctx)
+		}
+		if !errors.Is(err, ErrServerError) && !errors.Is(err, ErrTimeout) {
+			return err
+		}
+	}
+
+	return lastErr
+}
+
+// wrapCtxErr converts ctx.Err() into the package's own ErrTimeout/ErrCanceled
+// sentinels, so callers checking errors.Is against those (IsRetryable,
+// isOfflineFallbackError) see caller-driven deadlines and cancellations the
+// same way they see a server-side timeout, instead of only ever matching the
+// raw stdlib context.DeadlineExceeded/context.Canceled.
+func wrapCtxErr(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return NewSchemaRegistryError(ErrTimeout, ctx.Err().Error(), 0)
+	}
+	return NewSchemaRegistryError(ErrCanceled, ctx.Err().Error(), 0)
+}
+
+// doRequestTo performs a single HTTP request against endpoint and unmarshals
+// the response. result must be a pointer to the target struct or nil for no
+// response body.
+//
+// If a circuit breaker is configured (RetryConfig.CircuitBreaker) and
+// endpoint's failure ratio has tripped it, the request is short-circuited to
+// ErrCircuitOpen without touching the network.
+func (c *Client) doRequestTo(ctx context.Context, endpoint, method, path string, body []byte, result interface{}) (err error) {
+	if c.rateLimiter != nil {
+		deferred, werr := c.rateLimiter.wait(ctx, endpoint)
+		if werr != nil {
+			return werr
+		}
+		if deferred {
+			c.requestStats.addDeferred()
+		}
+	}
+
+	if c.circuitBreaker != nil {
+		if !c.circuitBreaker.allow(endpoint) {
+			return NewSchemaRegistryError(ErrCircuitOpen, "endpoint "+endpoint+" is short-circuited", 0)
+		}
+		defer func() {
+			c.circuitBreaker.recordResult(endpoint, err == nil)
+		}()
+	}
+
 	// Build full URL
-	fullURL := c.baseURL + path
+	fullURL := endpoint + path
 
 	// Create request
 	var bodyReader io.Reader
@@ -410,8 +843,15 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body []byte
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	if c.credentials != nil {
+		if err := c.credentials.ApplyTo(ctx, req); err != nil {
+			return fmt.Errorf("failed to apply credentials: %w", err)
+		}
+	}
+	if c.signer != nil {
+		if err := c.signer.Sign(ctx, req, body); err != nil {
+			return fmt.Errorf("failed to sign request: %w", err)
+		}
 	}
 
 	// Execute request
@@ -419,9 +859,9 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body []byte
 	if err != nil {
 		// Check if context was canceled
 		if ctx.Err() != nil {
-			return ctx.Err()
+			return wrapCtxErr(ctx)
 		}
-		return fmt.Errorf("request failed: %w", err)
+		return NewSchemaRegistryError(ErrServerError, err.Error(), 0)
 	}
 	defer resp.Body.Close()
 
@@ -433,7 +873,10 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body []byte
 
 	// Check for errors
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return mapHTTPError(resp.StatusCode, respBody)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			c.requestStats.addThrottled()
+		}
+		return mapHTTPError(resp.StatusCode, respBody, resp.Header)
 	}
 
 	// Unmarshal response (if result is not nil)
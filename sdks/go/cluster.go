@@ -0,0 +1,287 @@
+package schema_registry
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSyncInterval is the default interval at which the client refreshes
+// its endpoint list from the server's cluster membership view.
+const DefaultSyncInterval = 30 * time.Second
+
+// EndpointStats reports per-endpoint counters maintained by the client's
+// endpoint pool.
+type EndpointStats struct {
+	// Endpoint is the endpoint's base URL.
+	Endpoint string
+	// Healthy indicates whether the endpoint is currently considered usable.
+	Healthy bool
+	// Requests is the number of requests attempted against this endpoint.
+	Requests uint64
+	// Failures is the number of requests that failed against this endpoint.
+	Failures uint64
+	// LastError is the most recent error observed for this endpoint, if any.
+	LastError error
+	// LastSyncTime is when the endpoint list was last refreshed from the server.
+	LastSyncTime time.Time
+}
+
+// ClusterStats reports the state of every endpoint in the client's pool.
+type ClusterStats struct {
+	Endpoints []EndpointStats
+}
+
+// clusterMember is a single entry returned by GET /v1/cluster/members.
+type clusterMember struct {
+	Endpoint string `json:"endpoint"`
+}
+
+// endpointEntry tracks health and counters for a single endpoint.
+type endpointEntry struct {
+	url          string
+	healthy      bool
+	requests     uint64
+	failures     uint64
+	lastError    error
+	lastSyncTime time.Time
+}
+
+// endpointPool is a thread-safe, round-robin pool of registry endpoints with
+// per-endpoint health tracking and failover.
+type endpointPool struct {
+	mu      sync.Mutex
+	entries []*endpointEntry
+	next    int
+}
+
+// newEndpointPool creates a pool seeded with the given endpoint URLs.
+func newEndpointPool(urls []string) *endpointPool {
+	p := &endpointPool{}
+	p.setEndpoints(urls)
+	return p
+}
+
+// setEndpoints replaces the pool's endpoint list, preserving counters for
+// endpoints that remain present.
+func (p *endpointPool) setEndpoints(urls []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	existing := make(map[string]*endpointEntry, len(p.entries))
+	for _, e := range p.entries {
+		existing[e.url] = e
+	}
+
+	entries := make([]*endpointEntry, 0, len(urls))
+	for _, u := range urls {
+		u = strings.TrimSuffix(u, "/")
+		if e, ok := existing[u]; ok {
+			entries = append(entries, e)
+			continue
+		}
+		entries = append(entries, &endpointEntry{url: u, healthy: true})
+	}
+
+	p.entries = entries
+	p.next = 0
+}
+
+// markSynced records that the endpoint list was refreshed at now.
+func (p *endpointPool) markSynced(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range p.entries {
+		e.lastSyncTime = now
+	}
+}
+
+// urls returns the current endpoint URLs in pool order.
+func (p *endpointPool) urls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	urls := make([]string, len(p.entries))
+	for i, e := range p.entries {
+		urls[i] = e.url
+	}
+	return urls
+}
+
+// pickOrder returns the endpoint URLs to try, starting from the next
+// round-robin position and preferring healthy endpoints. If every endpoint
+// is unhealthy, all are returned so the caller can still attempt a request.
+func (p *endpointPool) pickOrder() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := len(p.entries)
+	if n == 0 {
+		return nil
+	}
+
+	ordered := make([]*endpointEntry, n)
+	for i := 0; i < n; i++ {
+		ordered[i] = p.entries[(p.next+i)%n]
+	}
+	p.next = (p.next + 1) % n
+
+	healthy := make([]string, 0, n)
+	for _, e := range ordered {
+		if e.healthy {
+			healthy = append(healthy, e.url)
+		}
+	}
+	if len(healthy) > 0 {
+		return healthy
+	}
+
+	all := make([]string, n)
+	for i, e := range ordered {
+		all[i] = e.url
+	}
+	return all
+}
+
+// markSuccess records a successful request against endpoint.
+func (p *endpointPool) markSuccess(endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range p.entries {
+		if e.url == endpoint {
+			e.requests++
+			e.healthy = true
+			e.lastError = nil
+			return
+		}
+	}
+}
+
+// markFailure records a failed request against endpoint.
+func (p *endpointPool) markFailure(endpoint string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range p.entries {
+		if e.url == endpoint {
+			e.requests++
+			e.failures++
+			e.lastError = err
+			e.healthy = false
+			return
+		}
+	}
+}
+
+// stats returns a snapshot of every endpoint's counters.
+func (p *endpointPool) stats() ClusterStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]EndpointStats, len(p.entries))
+	for i, e := range p.entries {
+		out[i] = EndpointStats{
+			Endpoint:     e.url,
+			Healthy:      e.healthy,
+			Requests:     e.requests,
+			Failures:     e.failures,
+			LastError:    e.lastError,
+			LastSyncTime: e.lastSyncTime,
+		}
+	}
+	return ClusterStats{Endpoints: out}
+}
+
+// Endpoints returns the client's current endpoint list.
+func (c *Client) Endpoints() []string {
+	if c.endpoints == nil {
+		return []string{c.baseURL}
+	}
+	return c.endpoints.urls()
+}
+
+// SetEndpoints replaces the client's endpoint list, overriding whatever the
+// background sync loop (if any) last observed.
+func (c *Client) SetEndpoints(urls []string) {
+	if c.endpoints == nil {
+		c.endpoints = newEndpointPool(urls)
+		return
+	}
+	c.endpoints.setEndpoints(urls)
+}
+
+// ClusterStats returns per-endpoint request counters alongside the existing
+// CacheStats, useful for observing failover and sync behavior.
+func (c *Client) ClusterStats() ClusterStats {
+	if c.endpoints == nil {
+		return ClusterStats{}
+	}
+	return c.endpoints.stats()
+}
+
+// startEndpointSync launches the background goroutine that periodically
+// refreshes the endpoint list from the server's own membership view. It is a
+// no-op if syncInterval is not positive.
+func (c *Client) startEndpointSync(syncInterval time.Duration) {
+	if syncInterval <= 0 || c.endpoints == nil {
+		return
+	}
+
+	c.syncStop = make(chan struct{})
+	c.syncDone = make(chan struct{})
+
+	go func() {
+		defer close(c.syncDone)
+
+		ticker := time.NewTicker(syncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.syncStop:
+				return
+			case <-ticker.C:
+				c.syncEndpointsOnce()
+			}
+		}
+	}()
+}
+
+// syncEndpointsOnce fetches the cluster membership list from the first
+// reachable endpoint and updates the pool.
+func (c *Client) syncEndpointsOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var members []clusterMember
+	_ = Retry(ctx, c.retryConfig, func() error {
+		return c.doRequest(ctx, http.MethodGet, "/v1/cluster/members", nil, &members)
+	})
+
+	if len(members) == 0 {
+		c.endpoints.markSynced(time.Now())
+		return
+	}
+
+	urls := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.Endpoint != "" {
+			urls = append(urls, m.Endpoint)
+		}
+	}
+	if len(urls) > 0 {
+		c.endpoints.setEndpoints(urls)
+	}
+	c.endpoints.markSynced(time.Now())
+}
+
+// stopEndpointSync stops the background sync goroutine, if running, and
+// waits for it to exit.
+func (c *Client) stopEndpointSync() {
+	if c.syncStop == nil {
+		return
+	}
+	close(c.syncStop)
+	<-c.syncDone
+}
@@ -0,0 +1,127 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClusterFailover(t *testing.T) {
+	var badHits, goodHits int32
+
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&badHits, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer bad.Close()
+
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&goodHits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":"healthy"}`)
+	}))
+	defer good.Close()
+
+	retryCfg := DefaultRetryConfig()
+	retryCfg.MaxAttempts = 0
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:     bad.URL,
+		Endpoints:   []string{bad.URL, good.URL},
+		RetryConfig: &retryCfg,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	health, err := client.HealthCheck(context.Background())
+	if err != nil {
+		t.Fatalf("expected failover to succeed, got error: %v", err)
+	}
+	if health.Status != "healthy" {
+		t.Errorf("unexpected status: %s", health.Status)
+	}
+
+	if atomic.LoadInt32(&badHits) == 0 || atomic.LoadInt32(&goodHits) == 0 {
+		t.Errorf("expected both endpoints to be hit, bad=%d good=%d", badHits, goodHits)
+	}
+
+	stats := client.ClusterStats()
+	if len(stats.Endpoints) != 2 {
+		t.Fatalf("expected 2 endpoints in cluster stats, got %d", len(stats.Endpoints))
+	}
+}
+
+func TestClusterAutoSync(t *testing.T) {
+	members := `[{"endpoint":"` + "http://replica-1:9090" + `"},{"endpoint":"` + "http://replica-2:9090" + `"}]`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "cluster/members") {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, members)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		Endpoints:    []string{server.URL},
+		SyncInterval: 20 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		urls := client.Endpoints()
+		if len(urls) == 2 {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("endpoint list was not updated by auto-sync, got %v", client.Endpoints())
+}
+
+func TestClusterCleanShutdown(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		Endpoints:    []string{server.URL},
+		SyncInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	deadline := time.Now().Add(1 * time.Second)
+	for time.Now().Before(deadline) {
+		if runtime.NumGoroutine() <= before+1 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("possible goroutine leak: before=%d after=%d", before, runtime.NumGoroutine())
+}
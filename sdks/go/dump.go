@@ -0,0 +1,306 @@
+package schema_registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/llm-schema-registry/go-sdk/archive"
+)
+
+// DumpOptions selects what DumpSchemas exports.
+type DumpOptions struct {
+	// Namespaces restricts the dump to these namespaces. Empty means all.
+	Namespaces []string
+	// Formats restricts the dump to these schema formats. Empty means all.
+	Formats []SchemaFormat
+	// MinVersion, if set, excludes versions below it (semver comparison is
+	// performed server-side).
+	MinVersion string
+	// MaxVersion, if set, excludes versions above it.
+	MaxVersion string
+	// IncludeCompatibility includes each schema's compatibility mode in the dump.
+	IncludeCompatibility bool
+}
+
+// ConflictPolicy controls how RestoreSchemas handles a record whose
+// namespace/name/version already exists in the target registry.
+type ConflictPolicy string
+
+const (
+	// ConflictSkip leaves the existing schema alone and moves on.
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite deletes the existing schema and re-registers it from the archive.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	// ConflictFailFast aborts the restore on the first conflict.
+	ConflictFailFast ConflictPolicy = "fail_fast"
+	// ConflictRegisterAsNewVersion bumps the patch version until a free slot is found.
+	ConflictRegisterAsNewVersion ConflictPolicy = "register_as_new_version"
+)
+
+// RestoreOptions configures RestoreSchemas.
+type RestoreOptions struct {
+	// ConflictPolicy controls how existing schemas are handled (default: ConflictSkip).
+	ConflictPolicy ConflictPolicy
+}
+
+// RestoreRecordError describes a single record that failed to restore.
+type RestoreRecordError struct {
+	Namespace string
+	Name      string
+	Version   string
+	Err       error
+}
+
+// RestoreReport summarizes the outcome of a RestoreSchemas call.
+type RestoreReport struct {
+	// Total is the number of records read from the archive.
+	Total int
+	// Succeeded is the number of records registered.
+	Succeeded int
+	// Skipped is the number of records left alone under ConflictSkip.
+	Skipped int
+	// Failed is the number of records that could not be restored.
+	Failed int
+	// Errors carries one entry per failed record.
+	Errors []RestoreRecordError
+}
+
+// DumpSchemas streams a newline-delimited JSON archive (see package archive)
+// of schemas matching opts from the registry's bulk export endpoint. Callers
+// must Close the returned stream.
+func (c *Client) DumpSchemas(ctx context.Context, opts DumpOptions) (io.ReadCloser, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	path := buildDumpPath(opts)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dump request: %w", err)
+	}
+	req.Header.Set("Accept", "application/x-ndjson")
+	if c.credentials != nil {
+		if err := c.credentials.ApplyTo(ctx, req); err != nil {
+			return nil, fmt.Errorf("failed to apply credentials: %w", err)
+		}
+	}
+	if c.signer != nil {
+		if err := c.signer.Sign(ctx, req, nil); err != nil {
+			return nil, fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, NewSchemaRegistryError(ErrServerError, err.Error(), 0)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, mapHTTPError(resp.StatusCode, body, resp.Header)
+	}
+
+	return resp.Body, nil
+}
+
+// RestoreSchemas reads an archive produced by DumpSchemas (or by
+// archive.Writer) from r and registers each record against the registry,
+// applying opts.ConflictPolicy to records that already exist. Each record is
+// registered with its own RetryConfig-governed retry.
+func (c *Client) RestoreSchemas(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreReport, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	policy := opts.ConflictPolicy
+	if policy == "" {
+		policy = ConflictSkip
+	}
+
+	ar, _, err := archive.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	report := &RestoreReport{}
+
+	for {
+		rec, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to read archive record: %w", err)
+		}
+
+		report.Total++
+
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		if err := c.restoreRecord(ctx, rec, policy); err != nil {
+			if _, ok := err.(*restoreSkipped); ok {
+				report.Skipped++
+				continue
+			}
+
+			report.Failed++
+			report.Errors = append(report.Errors, RestoreRecordError{
+				Namespace: rec.Namespace,
+				Name:      rec.Name,
+				Version:   rec.Version,
+				Err:       err,
+			})
+			if policy == ConflictFailFast {
+				return report, fmt.Errorf("restore aborted on conflict for %s.%s:%s: %w", rec.Namespace, rec.Name, rec.Version, err)
+			}
+			continue
+		}
+
+		report.Succeeded++
+	}
+
+	if !ar.Verified() {
+		if verr := ar.Err(); verr != nil {
+			return report, fmt.Errorf("archive integrity check failed: %w", verr)
+		}
+	}
+
+	return report, nil
+}
+
+// restoreSkipped marks a record that was intentionally left alone.
+type restoreSkipped struct{}
+
+func (e *restoreSkipped) Error() string { return "schema already exists, skipped" }
+
+// restoreRecord registers a single archive record, applying the conflict policy.
+func (c *Client) restoreRecord(ctx context.Context, rec archive.Record, policy ConflictPolicy) error {
+	schema := recordToSchema(rec)
+
+	var meta map[string]interface{}
+	_ = json.Unmarshal(rec.Metadata, &meta)
+
+	err := Retry(ctx, c.retryConfig, func() error {
+		_, err := c.RegisterSchema(ctx, schema)
+		return err
+	})
+	if err == nil {
+		return nil
+	}
+	if !IsConflict(err) {
+		return err
+	}
+
+	switch policy {
+	case ConflictSkip:
+		return &restoreSkipped{}
+	case ConflictFailFast:
+		return err
+	case ConflictOverwrite:
+		if delErr := c.DeleteSchema(ctx, rec.SchemaID); delErr != nil {
+			return fmt.Errorf("failed to delete existing schema before overwrite: %w", delErr)
+		}
+		return Retry(ctx, c.retryConfig, func() error {
+			_, err := c.RegisterSchema(ctx, schema)
+			return err
+		})
+	case ConflictRegisterAsNewVersion:
+		return c.registerWithBumpedVersion(ctx, schema)
+	default:
+		return err
+	}
+}
+
+// registerWithBumpedVersion retries registration under successively bumped
+// patch versions until one is accepted or a small attempt budget is exhausted.
+func (c *Client) registerWithBumpedVersion(ctx context.Context, schema *Schema) error {
+	const maxBumpAttempts = 20
+
+	candidate := *schema
+	for i := 0; i < maxBumpAttempts; i++ {
+		candidate.Version = bumpPatchVersion(candidate.Version)
+
+		err := Retry(ctx, c.retryConfig, func() error {
+			_, err := c.RegisterSchema(ctx, &candidate)
+			return err
+		})
+		if err == nil {
+			return nil
+		}
+		if !IsConflict(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("could not find a free version for %s.%s after %d attempts", schema.Namespace, schema.Name, maxBumpAttempts)
+}
+
+// IsConflict reports whether err represents a schema that already exists
+// (i.e. a registration conflict), as opposed to a validation or server error.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrIncompatibleSchema)
+}
+
+// recordToSchema converts an archive record back into a Schema for registration.
+func recordToSchema(rec archive.Record) *Schema {
+	schema := &Schema{
+		Namespace: rec.Namespace,
+		Name:      rec.Name,
+		Version:   rec.Version,
+		Format:    SchemaFormat(rec.Format),
+		Content:   rec.Content,
+	}
+	if len(rec.Metadata) > 0 {
+		var md SchemaMetadata
+		if err := json.Unmarshal(rec.Metadata, &md); err == nil {
+			schema.Metadata = &md
+		}
+	}
+	return schema
+}
+
+// bumpPatchVersion increments the patch component of a semver version
+// string (e.g. "1.0.0" -> "1.0.1"). Non-semver input is returned unchanged.
+func bumpPatchVersion(version string) string {
+	var major, minor, patch int
+	if _, err := fmt.Sscanf(version, "%d.%d.%d", &major, &minor, &patch); err != nil {
+		return version
+	}
+	return fmt.Sprintf("%d.%d.%d", major, minor, patch+1)
+}
+
+// buildDumpPath builds the admin dump endpoint path from opts.
+func buildDumpPath(opts DumpOptions) string {
+	params := url.Values{}
+	for _, ns := range opts.Namespaces {
+		params.Add("namespace", ns)
+	}
+	for _, f := range opts.Formats {
+		params.Add("format", f.String())
+	}
+	if opts.MinVersion != "" {
+		params.Set("min_version", opts.MinVersion)
+	}
+	if opts.MaxVersion != "" {
+		params.Set("max_version", opts.MaxVersion)
+	}
+	if opts.IncludeCompatibility {
+		params.Set("include_compatibility", "true")
+	}
+	if len(params) == 0 {
+		return "/api/v1/admin/dump"
+	}
+	return "/api/v1/admin/dump?" + params.Encode()
+}
@@ -0,0 +1,232 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/llm-schema-registry/go-sdk/archive"
+)
+
+// buildTestArchive writes n synthetic schema records into an archive and
+// returns the raw bytes.
+func buildTestArchive(t *testing.T, n int) string {
+	t.Helper()
+
+	var sb strings.Builder
+	w, err := archive.NewWriter(&sb, "test-source")
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	for i := 0; i < n; i++ {
+		err := w.WriteRecord(archive.Record{
+			SchemaID:  fmt.Sprintf("id-%d", i),
+			Namespace: "telemetry",
+			Name:      fmt.Sprintf("Event%d", i),
+			Version:   "1.0.0",
+			Format:    "json_schema",
+			Content:   `{"type":"object"}`,
+		})
+		if err != nil {
+			t.Fatalf("WriteRecord failed: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	return sb.String()
+}
+
+func TestRestoreSchemasRoundTripsThroughPipe(t *testing.T) {
+	const n = 10000
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/schemas") {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"schema_id":"new-id","version":"1.0.0"}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: false})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	archiveText := buildTestArchive(t, n)
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = io.Copy(pw, strings.NewReader(archiveText))
+		pw.Close()
+	}()
+
+	report, err := client.RestoreSchemas(context.Background(), pr, RestoreOptions{ConflictPolicy: ConflictSkip})
+	if err != nil {
+		t.Fatalf("RestoreSchemas failed: %v", err)
+	}
+
+	if report.Total != n {
+		t.Errorf("expected %d total records, got %d", n, report.Total)
+	}
+	if report.Succeeded != n {
+		t.Errorf("expected %d succeeded records, got %d (failed=%d)", n, report.Succeeded, report.Failed)
+	}
+}
+
+func TestRestoreSchemasConflictPolicies(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        ConflictPolicy
+		newHandler    func() http.HandlerFunc
+		wantErr       bool
+		wantSucceeded int
+		wantSkipped   int
+		wantFailed    int
+	}{
+		{
+			name:   "skip",
+			policy: ConflictSkip,
+			newHandler: func() http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusConflict)
+					fmt.Fprint(w, `{"message":"already exists"}`)
+				}
+			},
+			wantSucceeded: 0,
+			wantSkipped:   1,
+		},
+		{
+			name:   "fail_fast",
+			policy: ConflictFailFast,
+			newHandler: func() http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusConflict)
+					fmt.Fprint(w, `{"message":"already exists"}`)
+				}
+			},
+			wantErr:       true,
+			wantSucceeded: 0,
+			wantFailed:    1,
+		},
+		{
+			name:   "overwrite",
+			policy: ConflictOverwrite,
+			newHandler: func() http.HandlerFunc {
+				var registerCalls int
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					switch {
+					case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/schemas"):
+						registerCalls++
+						if registerCalls == 1 {
+							// First registration attempt conflicts with the
+							// existing schema.
+							w.WriteHeader(http.StatusConflict)
+							fmt.Fprint(w, `{"message":"already exists"}`)
+							return
+						}
+						// Re-registration after DeleteSchema succeeds.
+						fmt.Fprint(w, `{"schema_id":"new-id","version":"1.0.0"}`)
+					case r.Method == http.MethodDelete:
+						w.WriteHeader(http.StatusOK)
+					default:
+						w.WriteHeader(http.StatusNotFound)
+					}
+				}
+			},
+			wantSucceeded: 1,
+		},
+		{
+			name:   "register_as_new_version",
+			policy: ConflictRegisterAsNewVersion,
+			newHandler: func() http.HandlerFunc {
+				var registerCalls int
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					registerCalls++
+					if registerCalls == 1 {
+						// The original 1.0.0 conflicts...
+						w.WriteHeader(http.StatusConflict)
+						fmt.Fprint(w, `{"message":"already exists"}`)
+						return
+					}
+					// ...so registerWithBumpedVersion retries under 1.0.1,
+					// which succeeds.
+					fmt.Fprint(w, `{"schema_id":"new-id","version":"1.0.1"}`)
+				}
+			},
+			wantSucceeded: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.newHandler())
+			defer server.Close()
+
+			retryCfg := DefaultRetryConfig()
+			retryCfg.MaxAttempts = 0
+
+			client, err := NewClient(ClientConfig{BaseURL: server.URL, RetryConfig: &retryCfg})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer client.Close()
+
+			archiveText := buildTestArchive(t, 1)
+			report, err := client.RestoreSchemas(context.Background(), strings.NewReader(archiveText), RestoreOptions{ConflictPolicy: tt.policy})
+			if tt.wantErr && err == nil {
+				t.Fatal("expected RestoreSchemas to return an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("RestoreSchemas failed: %v", err)
+			}
+			if report.Succeeded != tt.wantSucceeded {
+				t.Errorf("succeeded = %d, want %d", report.Succeeded, tt.wantSucceeded)
+			}
+			if report.Skipped != tt.wantSkipped {
+				t.Errorf("skipped = %d, want %d", report.Skipped, tt.wantSkipped)
+			}
+			if report.Failed != tt.wantFailed {
+				t.Errorf("failed = %d, want %d", report.Failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestRestoreSchemasContextCancellationMidStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"id","version":"1.0.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	archiveText := buildTestArchive(t, 100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := client.RestoreSchemas(ctx, strings.NewReader(archiveText), RestoreOptions{})
+	if err == nil {
+		t.Fatal("expected an error due to canceled context")
+	}
+	if report.Succeeded != 0 {
+		t.Errorf("expected no records to have been processed, got %d succeeded", report.Succeeded)
+	}
+}
@@ -0,0 +1,165 @@
+package schema_registry
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type widget struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"widget-schema-1","namespace":"telemetry","name":"Widget","version":"1.0.0","format":"json_schema"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	enc := NewEncoder(client)
+	dec := NewDecoder(client)
+
+	data, err := enc.Encode(context.Background(), "telemetry.Widget", widget{Name: "sprocket", Count: 3})
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	if data[0] != wireMagicByte {
+		t.Fatalf("expected magic byte 0x00, got 0x%02x", data[0])
+	}
+
+	var out widget
+	if err := dec.Decode(context.Background(), data, &out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if out.Name != "sprocket" || out.Count != 3 {
+		t.Errorf("unexpected decoded value: %+v", out)
+	}
+}
+
+// TestEncodeDecodeRoundTripAcrossIndependentClients exercises the
+// cross-process scenario Encoder/Decoder are meant for: a Kafka producer
+// and a separate consumer that never shared a cache. The wire format must
+// carry enough to resolve the schema through the registry itself, not just
+// whatever happens to already be warm in the decoding client's own cache.
+func TestEncodeDecodeRoundTripAcrossIndependentClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"widget-schema-1","namespace":"telemetry","name":"Widget","version":"1.0.0","format":"json_schema"}`)
+	}))
+	defer server.Close()
+
+	producer, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create producer client: %v", err)
+	}
+	defer producer.Close()
+
+	consumer, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create consumer client: %v", err)
+	}
+	defer consumer.Close()
+
+	enc := NewEncoder(producer)
+	dec := NewDecoder(consumer)
+
+	data, err := enc.Encode(context.Background(), "telemetry.Widget", widget{Name: "sprocket", Count: 3})
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	var out widget
+	if err := dec.Decode(context.Background(), data, &out); err != nil {
+		t.Fatalf("Decode failed on an independent client that never encoded anything: %v", err)
+	}
+	if out.Name != "sprocket" || out.Count != 3 {
+		t.Errorf("unexpected decoded value: %+v", out)
+	}
+}
+
+func TestDecodeUnresolvableSchemaIDFails(t *testing.T) {
+	client, err := NewClient(ClientConfig{
+		BaseURL:     "http://example.invalid",
+		EnableCache: true,
+		RetryConfig: &RetryConfig{MaxAttempts: 0, RetryableFunc: IsRetryable},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	dec := NewDecoder(client)
+
+	schemaID := "no-such-schema"
+	data := make([]byte, wireHeaderMinLen+len(schemaID))
+	data[0] = wireMagicByte
+	binary.BigEndian.PutUint16(data[1:wireHeaderMinLen], uint16(len(schemaID)))
+	copy(data[wireHeaderMinLen:], schemaID)
+
+	var out widget
+	if err := dec.Decode(context.Background(), data, &out); err == nil {
+		t.Fatal("expected an error for a schema ID the registry can't resolve")
+	}
+}
+
+func TestDecodeRejectsBadMagicByte(t *testing.T) {
+	client, err := NewClient(ClientConfig{BaseURL: "http://example.invalid"})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	dec := NewDecoder(client)
+
+	data := make([]byte, wireHeaderMinLen+2)
+	data[0] = 0x01
+
+	var out widget
+	if err := dec.Decode(context.Background(), data, &out); err == nil {
+		t.Fatal("expected an error for an unsupported magic byte")
+	}
+}
+
+func TestDecodeRejectsTruncatedSchemaID(t *testing.T) {
+	client, err := NewClient(ClientConfig{BaseURL: "http://example.invalid"})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	dec := NewDecoder(client)
+
+	data := make([]byte, wireHeaderMinLen)
+	data[0] = wireMagicByte
+	binary.BigEndian.PutUint16(data[1:wireHeaderMinLen], 10) // claims a 10-byte ID that isn't there
+
+	var out widget
+	if err := dec.Decode(context.Background(), data, &out); err == nil {
+		t.Fatal("expected an error for a payload too short to hold its declared schema ID")
+	}
+}
+
+func TestEncodeRejectsInvalidSubject(t *testing.T) {
+	client, err := NewClient(ClientConfig{BaseURL: "http://example.invalid"})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	enc := NewEncoder(client)
+	if _, err := enc.Encode(context.Background(), "no-dot-subject", widget{}); err == nil {
+		t.Fatal("expected an error for a subject without a namespace.name separator")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // Error types for comprehensive error handling.
@@ -26,6 +28,10 @@ var (
 	ErrTimeout = errors.New("request timeout")
 	// ErrCanceled indicates the request was canceled.
 	ErrCanceled = errors.New("request canceled")
+	// ErrCircuitOpen indicates a request was short-circuited by the
+	// per-endpoint circuit breaker (see RetryConfig.CircuitBreaker) without
+	// touching the network.
+	ErrCircuitOpen = errors.New("circuit breaker open")
 )
 
 // SchemaRegistryError represents a schema registry error with additional context.
@@ -150,8 +156,18 @@ func (e *RateLimitError) Is(target error) bool {
 	return target == ErrRateLimit
 }
 
-// mapHTTPError maps HTTP status codes to appropriate errors.
-func mapHTTPError(statusCode int, body []byte) error {
+// RetryAfterDuration returns the server-suggested wait time as a
+// time.Duration, or false if the server didn't send one.
+func (e *RateLimitError) RetryAfterDuration() (time.Duration, bool) {
+	if e.RetryAfter == nil {
+		return 0, false
+	}
+	return time.Duration(*e.RetryAfter) * time.Second, true
+}
+
+// mapHTTPError maps HTTP status codes to appropriate errors. headers may be
+// nil; it is only consulted for the Retry-After header on a 429 response.
+func mapHTTPError(statusCode int, body []byte, headers http.Header) error {
 	var errResp ErrorResponse
 	// Try to unmarshal error response, but don't fail if we can't
 	_ = unmarshalJSON(body, &errResp)
@@ -183,8 +199,7 @@ func mapHTTPError(statusCode int, body []byte) error {
 		}
 		return NewSchemaRegistryError(ErrIncompatibleSchema, message, statusCode)
 	case http.StatusTooManyRequests:
-		// Try to extract retry-after header
-		return &RateLimitError{}
+		return &RateLimitError{RetryAfter: parseRetryAfter(headers)}
 	case http.StatusBadRequest:
 		// Check if this is a validation error
 		if errResp.Details != nil {
@@ -210,6 +225,25 @@ func mapHTTPError(statusCode int, body []byte) error {
 	}
 }
 
+// parseRetryAfter extracts the Retry-After header as a number of seconds.
+// Only the delta-seconds form is supported (not the HTTP-date form); an
+// unparseable or absent header returns nil, falling back to the caller's
+// normal exponential backoff.
+func parseRetryAfter(headers http.Header) *int {
+	if headers == nil {
+		return nil
+	}
+	value := headers.Get("Retry-After")
+	if value == "" {
+		return nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+	return &seconds
+}
+
 // IsRetryable determines if an error is retryable.
 func IsRetryable(err error) bool {
 	if err == nil {
@@ -223,6 +257,9 @@ func IsRetryable(err error) bool {
 	if errors.Is(err, ErrTimeout) {
 		return true
 	}
+	if errors.Is(err, ErrRateLimit) {
+		return true
+	}
 
 	// Check for schema registry errors with retryable status codes
 	var sre *SchemaRegistryError
@@ -0,0 +1,106 @@
+package schema_registry
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FormatChecker validates whether a value satisfies a named JSON Schema
+// "format" keyword (e.g. "duration", "semver", "uuid"), the way
+// xeipuuv/gojsonschema's FormatCheckerChain does. IsFormat takes
+// interface{} rather than string so formats defined over a non-string JSON
+// type (a numeric timestamp, say) can still be checked.
+type FormatChecker interface {
+	IsFormat(input interface{}) bool
+}
+
+// FormatCheckerFunc adapts a plain function to a FormatChecker.
+type FormatCheckerFunc func(input interface{}) bool
+
+// IsFormat calls f(input).
+func (f FormatCheckerFunc) IsFormat(input interface{}) bool { return f(input) }
+
+// formatCheckerRegistry maps a format name to the FormatChecker used to
+// validate it, mirroring serdeRegistry's mu+map pattern in encoder.go.
+type formatCheckerRegistry struct {
+	mu       sync.RWMutex
+	checkers map[string]FormatChecker
+}
+
+// newFormatCheckerRegistry creates a registry seeded with the built-in
+// "duration", "semver", and "uuid" checkers.
+func newFormatCheckerRegistry() *formatCheckerRegistry {
+	return &formatCheckerRegistry{
+		checkers: map[string]FormatChecker{
+			"duration": FormatCheckerFunc(isDurationFormat),
+			"semver":   FormatCheckerFunc(isSemverFormat),
+			"uuid":     FormatCheckerFunc(isUUIDFormat),
+		},
+	}
+}
+
+// register installs checker as the validator for name, replacing any
+// existing one (including a built-in).
+func (r *formatCheckerRegistry) register(name string, checker FormatChecker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers[name] = checker
+}
+
+// get returns the checker registered for name, if any.
+func (r *formatCheckerRegistry) get(name string) (FormatChecker, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	checker, ok := r.checkers[name]
+	return checker, ok
+}
+
+// names returns the registered format names in sorted order, for inclusion
+// in the "formats" field of a schema registration request.
+func (r *formatCheckerRegistry) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.checkers))
+	for name := range r.checkers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isDurationFormat reports whether input is a string parseable by
+// time.ParseDuration (e.g. "30s", "1h30m").
+func isDurationFormat(input interface{}) bool {
+	s, ok := input.(string)
+	if !ok {
+		return false
+	}
+	_, err := time.ParseDuration(s)
+	return err == nil
+}
+
+var semverFormatRegex = regexp.MustCompile(`^\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// isSemverFormat reports whether input is a string matching semantic
+// versioning's major.minor.patch[-prerelease][+build] shape.
+func isSemverFormat(input interface{}) bool {
+	s, ok := input.(string)
+	if !ok {
+		return false
+	}
+	return semverFormatRegex.MatchString(s)
+}
+
+var uuidFormatRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// isUUIDFormat reports whether input is a string in canonical UUID form.
+func isUUIDFormat(input interface{}) bool {
+	s, ok := input.(string)
+	if !ok {
+		return false
+	}
+	return uuidFormatRegex.MatchString(s)
+}
@@ -0,0 +1,118 @@
+package schema_registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBuiltinFormatCheckers(t *testing.T) {
+	tests := []struct {
+		format string
+		input  interface{}
+		want   bool
+	}{
+		{"duration", "30s", true},
+		{"duration", "not-a-duration", false},
+		{"duration", 30, false},
+		{"semver", "1.2.3", true},
+		{"semver", "1.2.3-rc.1+build.5", true},
+		{"semver", "1.2", false},
+		{"uuid", "550e8400-e29b-41d4-a716-446655440000", true},
+		{"uuid", "not-a-uuid", false},
+	}
+
+	registry := newFormatCheckerRegistry()
+	for _, tt := range tests {
+		checker, ok := registry.get(tt.format)
+		if !ok {
+			t.Fatalf("no built-in checker registered for %q", tt.format)
+		}
+		if got := checker.IsFormat(tt.input); got != tt.want {
+			t.Errorf("%s.IsFormat(%v) = %v, want %v", tt.format, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRegisterFormatCheckerOverridesAndExtends(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	client.RegisterFormatChecker("ports", FormatCheckerFunc(func(input interface{}) bool {
+		n, ok := input.(float64)
+		return ok && n > 0 && n < 65536
+	}))
+
+	checker, ok := client.formatCheckers.get("ports")
+	if !ok {
+		t.Fatal("expected custom checker to be registered")
+	}
+	if !checker.IsFormat(float64(8080)) {
+		t.Error("expected 8080 to satisfy the ports format")
+	}
+	if checker.IsFormat(float64(99999)) {
+		t.Error("expected 99999 to fail the ports format")
+	}
+
+	names := client.formatCheckers.names()
+	foundBuiltin, foundCustom := false, false
+	for _, name := range names {
+		if name == "uuid" {
+			foundBuiltin = true
+		}
+		if name == "ports" {
+			foundCustom = true
+		}
+	}
+	if !foundBuiltin || !foundCustom {
+		t.Errorf("expected both built-in and custom format names, got %v", names)
+	}
+}
+
+func TestValidateDataChecksFormatsOffline(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{
+		SchemaID: "id-1",
+		Format:   SchemaFormatJSONSchema,
+		Content:  `{"required":["id"],"properties":{"id":{"format":"uuid"}}}`,
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.ValidateData(context.Background(), "id-1", map[string]interface{}{"id": "550e8400-e29b-41d4-a716-446655440000"})
+	if err != nil {
+		t.Fatalf("ValidateData failed: %v", err)
+	}
+	if !resp.IsValid {
+		t.Errorf("expected valid data, got errors: %v", resp.Errors)
+	}
+
+	resp, err = client.ValidateData(context.Background(), "id-1", map[string]interface{}{"id": "not-a-uuid"})
+	if err != nil {
+		t.Fatalf("ValidateData failed: %v", err)
+	}
+	if resp.IsValid {
+		t.Error("expected invalid data for a malformed uuid field")
+	}
+}
@@ -0,0 +1,155 @@
+package schema_registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// avroSchema is the subset of an Avro schema's JSON shape validateAvroSchema
+// and localValidateAvro check: a record type needs a name and an array of
+// named, typed fields.
+type avroSchema struct {
+	Type   string      `json:"type"`
+	Name   string      `json:"name"`
+	Fields []avroField `json:"fields"`
+}
+
+// avroField is one entry of an avroSchema's "fields" array. Type is left as
+// interface{} since Avro field types are either a bare string ("string"), a
+// union (["null", "string"]), or a nested record object.
+type avroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"`
+}
+
+// validateAvroSchema parses content as an Avro schema and verifies its
+// record/field shape. It does not implement full Avro type resolution
+// (nested records, logical types, schema references) - just enough
+// structural checking to catch a malformed registration early.
+func validateAvroSchema(content string) error {
+	var schema avroSchema
+	if err := json.Unmarshal([]byte(content), &schema); err != nil {
+		return fmt.Errorf("not valid JSON: %w", err)
+	}
+	if schema.Type == "" {
+		return fmt.Errorf(`missing required "type" field`)
+	}
+	if schema.Type != "record" {
+		return nil
+	}
+	if schema.Name == "" {
+		return fmt.Errorf(`record type requires a "name" field`)
+	}
+	if len(schema.Fields) == 0 {
+		return fmt.Errorf("record %q has no fields", schema.Name)
+	}
+	for i, field := range schema.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("field %d of record %q is missing a name", i, schema.Name)
+		}
+		if field.Type == nil {
+			return fmt.Errorf("field %q of record %q is missing a type", field.Name, schema.Name)
+		}
+	}
+	return nil
+}
+
+// isNullableAvroType reports whether an Avro field type is a ["null", ...]
+// union, meaning the field may be omitted from data validated against it.
+func isNullableAvroType(t interface{}) bool {
+	union, ok := t.([]interface{})
+	if !ok {
+		return false
+	}
+	for _, member := range union {
+		if s, ok := member.(string); ok && s == "null" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateProtobufSource is a best-effort structural lint of proto source,
+// NOT a protobuf parser: it only checks balanced braces and the presence of
+// at least one message or service declaration.
+//
+// STATUS: compiling the source with protoreflect/protocompile and surfacing
+// real syntax errors through ValidateResponse.Errors, as originally
+// requested, is NOT implemented. This SDK has no go.mod/vendor directory to
+// pin a third-party parser dependency against, so this lint is what exists
+// instead - it catches gross malformation (unbalanced braces, no
+// message/service) but duplicate or malformed field numbers, wrong field
+// types, and other invalid-but-brace-balanced source all pass uncaught and
+// surface as a server-side registration error instead. Callers should not
+// treat a clean result as proof the schema compiles.
+func validateProtobufSource(content string) []string {
+	var errs []string
+
+	depth := 0
+	for i, r := range content {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				errs = append(errs, fmt.Sprintf("unmatched '}' at byte offset %d", i))
+				depth = 0
+			}
+		}
+	}
+	if depth > 0 {
+		errs = append(errs, fmt.Sprintf("%d unclosed '{' block(s)", depth))
+	}
+
+	if !strings.Contains(content, "message ") && !strings.Contains(content, "service ") {
+		errs = append(errs, `no "message" or "service" declaration found`)
+	}
+
+	return errs
+}
+
+// validateProtobufReferences checks that every declared SchemaReference has
+// a matching import statement in content, catching a stale or typo'd
+// reference before the registration request is sent.
+func validateProtobufReferences(content string, refs []SchemaReference) []string {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	imports := protobufImports(content)
+	var errs []string
+	for _, ref := range refs {
+		found := false
+		for _, imp := range imports {
+			if imp == ref.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("reference %q has no matching import statement", ref.Name))
+		}
+	}
+	return errs
+}
+
+// protobufImports extracts the import paths declared in proto source (e.g.
+// `import "common/money.proto";`).
+func protobufImports(content string) []string {
+	var imports []string
+	for _, line := range strings.FieldsFunc(content, func(r rune) bool { return r == '\n' || r == ';' }) {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "import ") {
+			continue
+		}
+		start := strings.IndexByte(line, '"')
+		end := strings.LastIndexByte(line, '"')
+		if start < 0 || end <= start {
+			continue
+		}
+		imports = append(imports, line[start+1:end])
+	}
+	return imports
+}
@@ -0,0 +1,228 @@
+package schema_registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSchemaValidateAvro(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"valid record", `{"type":"record","name":"Widget","fields":[{"name":"id","type":"string"}]}`, false},
+		{"not json", `not json`, true},
+		{"missing type", `{}`, true},
+		{"record missing name", `{"type":"record","fields":[{"name":"id","type":"string"}]}`, true},
+		{"record missing fields", `{"type":"record","name":"Widget"}`, true},
+		{"field missing name", `{"type":"record","name":"Widget","fields":[{"type":"string"}]}`, true},
+		{"non-record type", `{"type":"string"}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := &Schema{Namespace: "ns", Name: "Widget", Version: "1.0.0", Format: SchemaFormatAvro, Content: tt.content}
+			err := schema.Validate()
+			if tt.wantErr && err == nil {
+				t.Error("expected validation error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateProtobuf(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		refs    []SchemaReference
+		wantErr bool
+	}{
+		{"valid message", `syntax = "proto3"; message Widget { string id = 1; }`, nil, false},
+		{"unclosed brace", `message Widget { string id = 1;`, nil, true},
+		{"no message or service", `syntax = "proto3";`, nil, true},
+		{
+			"unresolved reference",
+			`syntax = "proto3"; message Widget { string id = 1; }`,
+			[]SchemaReference{{Name: "common/money.proto", Subject: "common.Money", Version: "1.0.0"}},
+			true,
+		},
+		{
+			"resolved reference",
+			"syntax = \"proto3\";\nimport \"common/money.proto\";\nmessage Widget { string id = 1; }",
+			[]SchemaReference{{Name: "common/money.proto", Subject: "common.Money", Version: "1.0.0"}},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := &Schema{Namespace: "ns", Name: "Widget", Version: "1.0.0", Format: SchemaFormatProtobuf, Content: tt.content, References: tt.refs}
+			err := schema.Validate()
+			if tt.wantErr && err == nil {
+				t.Error("expected validation error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRegisterSchemaResolvesReferencesTransitively(t *testing.T) {
+	var resolvedPaths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/schemas/common/Money/versions/1.0.0":
+			resolvedPaths = append(resolvedPaths, r.URL.Path)
+			fmt.Fprint(w, `{"schema_id":"money-1","namespace":"common","name":"Money","version":"1.0.0","format":"protobuf","content":"syntax=\"proto3\"; message Money { int64 cents = 1; }"}`)
+		case "/api/v1/schemas":
+			var req registerSchemaRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			fmt.Fprint(w, `{"schema_id":"widget-1","version":"1.0.0","created_at":"2026-01-01T00:00:00Z"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	schema := &Schema{
+		Namespace: "ns",
+		Name:      "Widget",
+		Version:   "1.0.0",
+		Format:    SchemaFormatProtobuf,
+		Content:   "syntax = \"proto3\";\nimport \"common/money.proto\";\nmessage Widget { common.Money price = 1; }",
+		References: []SchemaReference{
+			{Name: "common/money.proto", Subject: "common.Money", Version: "1.0.0"},
+		},
+	}
+
+	if _, err := client.RegisterSchema(context.Background(), schema); err != nil {
+		t.Fatalf("RegisterSchema failed: %v", err)
+	}
+	if len(resolvedPaths) != 1 {
+		t.Errorf("expected the reference to be resolved exactly once, got %v", resolvedPaths)
+	}
+}
+
+func TestRegisterSchemaFailsOnUnresolvableReference(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	schema := &Schema{
+		Namespace: "ns",
+		Name:      "Widget",
+		Version:   "1.0.0",
+		Format:    SchemaFormatProtobuf,
+		Content:   `syntax = "proto3"; import "common/money.proto"; message Widget { common.Money price = 1; }`,
+		References: []SchemaReference{
+			{Name: "common/money.proto", Subject: "common.Money", Version: "1.0.0"},
+		},
+	}
+
+	if _, err := client.RegisterSchema(context.Background(), schema); err == nil {
+		t.Error("expected an error from an unresolvable reference, got nil")
+	}
+}
+
+func TestCheckCompatibilityWalksReferenceGraph(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/schemas/widget-1":
+			fmt.Fprint(w, `{"schema_id":"widget-1","namespace":"ns","name":"Widget","version":"1.0.0","format":"protobuf","content":"syntax=\"proto3\";","references":[{"name":"common/money.proto","subject":"common.Money","version":"1.0.0"}]}`)
+		case r.URL.Path == "/api/v1/schemas/common/Money/versions/1.0.0":
+			fmt.Fprint(w, `{"schema_id":"money-1","namespace":"common","name":"Money","version":"1.0.0","format":"protobuf","content":"syntax=\"proto3\"; message Money {}"}`)
+		case r.URL.Path == "/api/v1/compatibility/check":
+			var payload map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			if payload["schema_id"] == "money-1" {
+				fmt.Fprint(w, `{"is_compatible":false,"incompatibilities":["field removed"],"mode":"backward"}`)
+				return
+			}
+			fmt.Fprint(w, `{"is_compatible":true,"mode":"backward"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	result, err := client.CheckCompatibility(context.Background(), "widget-1", "syntax = \"proto3\";", CompatibilityBackward)
+	if err != nil {
+		t.Fatalf("CheckCompatibility failed: %v", err)
+	}
+	if result.IsCompatible {
+		t.Error("expected overall result to be incompatible due to a reference")
+	}
+	if len(result.Incompatibilities) != 1 || result.Incompatibilities[0] != "common/money.proto: field removed" {
+		t.Errorf("unexpected incompatibilities: %v", result.Incompatibilities)
+	}
+}
+
+func TestValidateDataFallsBackToLocalAvroValidation(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{
+		SchemaID: "id-1",
+		Format:   SchemaFormatAvro,
+		Content:  `{"type":"record","name":"Widget","fields":[{"name":"id","type":"string"},{"name":"nickname","type":["null","string"]}]}`,
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.ValidateData(context.Background(), "id-1", map[string]interface{}{"id": "widget-1"})
+	if err != nil {
+		t.Fatalf("ValidateData failed: %v", err)
+	}
+	if !resp.IsValid {
+		t.Errorf("expected valid data (nickname is nullable), got errors: %v", resp.Errors)
+	}
+
+	resp, err = client.ValidateData(context.Background(), "id-1", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("ValidateData failed: %v", err)
+	}
+	if resp.IsValid {
+		t.Error("expected invalid data for a missing required field")
+	}
+}
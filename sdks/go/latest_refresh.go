@@ -0,0 +1,127 @@
+package schema_registry
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// latestEntry tracks a single namespace/name pair that has been looked up at
+// version "latest", so the background refresh loop knows what to re-fetch.
+type latestEntry struct {
+	namespace string
+	name      string
+	path      string
+	cacheKey  string
+}
+
+// latestTracker records every "latest" lookup made through GetSchemaByVersion
+// so the background refresh loop can keep them warm without a synchronous
+// fetch on every cache miss.
+type latestTracker struct {
+	mu      sync.Mutex
+	entries map[string]*latestEntry
+}
+
+// track records that cacheKey was resolved as a "latest" lookup for
+// namespace/name at path.
+func (t *latestTracker) track(cacheKey, namespace, name, path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.entries == nil {
+		t.entries = make(map[string]*latestEntry)
+	}
+	t.entries[cacheKey] = &latestEntry{namespace: namespace, name: name, path: path, cacheKey: cacheKey}
+}
+
+// snapshot returns a copy of every tracked entry.
+func (t *latestTracker) snapshot() []*latestEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]*latestEntry, 0, len(t.entries))
+	for _, e := range t.entries {
+		out = append(out, e)
+	}
+	return out
+}
+
+// startLatestRefresh launches the background goroutine that periodically
+// re-fetches every tracked "latest" lookup and updates the cache in place,
+// so callers never pay a synchronous fetch once an entry is warm. It is a
+// no-op if ttl is not positive.
+//
+// There is no runtime.SetFinalizer safety net here: the goroutine itself
+// closes over c to call refreshLatestOnce, so c is always reachable while
+// it runs, and a finalizer registered on c would never fire. Callers must
+// call Close to stop the goroutine; Client intentionally mirrors the
+// Go convention of needing an explicit Close here rather than advertising a
+// GC-based cleanup it cannot deliver.
+func (c *Client) startLatestRefresh(ttl time.Duration) {
+	if ttl <= 0 || c.cache == nil {
+		return
+	}
+
+	c.latestRefresh = &latestTracker{}
+	c.latestRefreshStop = make(chan struct{})
+	c.latestRefreshDone = make(chan struct{})
+
+	stop := c.latestRefreshStop
+	done := c.latestRefreshDone
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(ttl)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				c.refreshLatestOnce()
+			}
+		}
+	}()
+}
+
+// refreshLatestOnce re-fetches every tracked "latest" entry and updates the
+// cache atomically, eliminating thundering-herd fetches when many goroutines
+// ask for the current version at the same time.
+func (c *Client) refreshLatestOnce() {
+	entries := c.latestRefresh.snapshot()
+	if len(entries) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, e := range entries {
+		resp := &GetSchemaResponse{}
+		err := Retry(ctx, c.retryConfig, func() error {
+			return c.doRequest(ctx, "GET", e.path, nil, resp)
+		})
+		if err != nil {
+			continue
+		}
+		c.cache.Set(e.cacheKey, resp)
+	}
+}
+
+// stopLatestRefresh stops the background latest-refresh goroutine, if
+// running, and waits for it to exit. It is safe to call more than once; Close
+// is the only thing that calls it, since (see startLatestRefresh) there is
+// no way to back it with a runtime finalizer.
+func (c *Client) stopLatestRefresh() {
+	if c.latestRefreshStop == nil {
+		return
+	}
+
+	c.latestRefreshStopOnce.Do(func() {
+		close(c.latestRefreshStop)
+		<-c.latestRefreshDone
+	})
+}
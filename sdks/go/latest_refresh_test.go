@@ -0,0 +1,94 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLatestRefreshUpdatesCacheInBackground(t *testing.T) {
+	var version int32 = 1
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := atomic.LoadInt32(&version)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"schema_id":"id-%d","namespace":"ns","name":"widget","version":"1.0.%d"}`, v, v)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		EnableCache:    true,
+		CacheLatestTTL: 20 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.GetSchemaByVersion(context.Background(), "ns", "widget", "latest")
+	if err != nil {
+		t.Fatalf("GetSchemaByVersion failed: %v", err)
+	}
+	if resp.Version != "1.0.1" {
+		t.Fatalf("unexpected initial version: %s", resp.Version)
+	}
+
+	atomic.StoreInt32(&version, 2)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		cached, err := client.GetSchemaByVersion(context.Background(), "ns", "widget", "latest")
+		if err != nil {
+			t.Fatalf("GetSchemaByVersion failed: %v", err)
+		}
+		if cached.Version == "1.0.2" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("expected background refresh to update the cached \"latest\" entry")
+}
+
+func TestLatestRefreshCleanShutdown(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"id","namespace":"ns","name":"widget","version":"1.0.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:        server.URL,
+		EnableCache:    true,
+		CacheLatestTTL: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.GetSchemaByVersion(context.Background(), "ns", "widget", "latest"); err != nil {
+		t.Fatalf("GetSchemaByVersion failed: %v", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	deadline := time.Now().Add(1 * time.Second)
+	for time.Now().Before(deadline) {
+		if runtime.NumGoroutine() <= before+1 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("possible goroutine leak: before=%d after=%d", before, runtime.NumGoroutine())
+}
@@ -0,0 +1,383 @@
+package schema_registry
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// LocalStore indexes one or more directories or tarballs of schema JSON
+// files, each organized as namespace/name/version.json, so GetSchema,
+// GetSchemaByVersion, ListVersions, and ValidateData can be served entirely
+// locally, without any HTTP calls. This mirrors kubeconform's offline
+// validation mode and is useful for air-gapped CI, sandboxed builds, or as
+// a fallback when the registry is unreachable (see ClientConfig.OfflineSchemaDirs).
+type LocalStore struct {
+	mu       sync.RWMutex
+	byKey    map[string]*GetSchemaResponse // "namespace.name:version"
+	byID     map[string]*GetSchemaResponse // schema_id
+	versions map[string][]*SchemaVersion   // "namespace.name"
+}
+
+// NewLocalStore builds a LocalStore from dirs, where each entry is either a
+// directory or a .tar/.tar.gz/.tgz archive containing
+// namespace/name/version.json files.
+func NewLocalStore(dirs []string) (*LocalStore, error) {
+	store := &LocalStore{
+		byKey:    make(map[string]*GetSchemaResponse),
+		byID:     make(map[string]*GetSchemaResponse),
+		versions: make(map[string][]*SchemaVersion),
+	}
+	for _, dir := range dirs {
+		if err := store.load(dir); err != nil {
+			return nil, fmt.Errorf("failed to load offline schema bundle %q: %w", dir, err)
+		}
+	}
+	return store, nil
+}
+
+// load indexes a single directory or tarball path.
+func (s *LocalStore) load(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return s.loadDir(path)
+	}
+	return s.loadTarball(path)
+}
+
+// loadDir walks root, indexing every namespace/name/version.json file found.
+func (s *LocalStore) loadDir(root string) error {
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, ".json") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		return s.index(rel, data)
+	})
+}
+
+// loadTarball indexes every namespace/name/version.json entry in a
+// .tar, .tar.gz, or .tgz archive at path.
+func (s *LocalStore) loadTarball(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".tgz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("failed to open gzip tarball: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tarball: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || !strings.HasSuffix(hdr.Name, ".json") {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := s.index(hdr.Name, data); err != nil {
+			return err
+		}
+	}
+}
+
+// index records a single namespace/name/version.json file's content,
+// identified by its path relative to the bundle root.
+func (s *LocalStore) index(relPath string, data []byte) error {
+	parts := strings.Split(filepath.ToSlash(relPath), "/")
+	if len(parts) < 3 {
+		return fmt.Errorf("unexpected schema bundle layout %q: want namespace/name/version.json", relPath)
+	}
+	parts = parts[len(parts)-3:]
+	namespace, name := parts[0], parts[1]
+	version := strings.TrimSuffix(parts[2], ".json")
+
+	var resp GetSchemaResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("failed to parse %q: %w", relPath, err)
+	}
+	if resp.Namespace == "" {
+		resp.Namespace = namespace
+	}
+	if resp.Name == "" {
+		resp.Name = name
+	}
+	if resp.Version == "" {
+		resp.Version = version
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.byKey[fmt.Sprintf("%s.%s:%s", namespace, name, version)] = &resp
+	if resp.SchemaID != "" {
+		s.byID[resp.SchemaID] = &resp
+	}
+	nameKey := fmt.Sprintf("%s.%s", namespace, name)
+	s.versions[nameKey] = append(s.versions[nameKey], &SchemaVersion{
+		Version:   version,
+		SchemaID:  resp.SchemaID,
+		CreatedAt: resp.CreatedAt,
+	})
+
+	return nil
+}
+
+// getByID returns the schema indexed under schemaID, if any.
+func (s *LocalStore) getByID(schemaID string) (*GetSchemaResponse, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resp, ok := s.byID[schemaID]
+	return resp, ok
+}
+
+// getByVersion returns the schema for namespace/name at version, resolving
+// version == "latest" to the highest semver version indexed.
+func (s *LocalStore) getByVersion(namespace, name, version string) (*GetSchemaResponse, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if version == "latest" {
+		return s.latestLocked(namespace, name)
+	}
+	resp, ok := s.byKey[fmt.Sprintf("%s.%s:%s", namespace, name, version)]
+	return resp, ok
+}
+
+// latestLocked returns the highest semver version indexed for
+// namespace/name. Callers must hold s.mu.
+func (s *LocalStore) latestLocked(namespace, name string) (*GetSchemaResponse, bool) {
+	versions := s.versions[fmt.Sprintf("%s.%s", namespace, name)]
+	if len(versions) == 0 {
+		return nil, false
+	}
+
+	best := versions[0]
+	for _, v := range versions[1:] {
+		if compareSemver(v.Version, best.Version) > 0 {
+			best = v
+		}
+	}
+	resp, ok := s.byKey[fmt.Sprintf("%s.%s:%s", namespace, name, best.Version)]
+	return resp, ok
+}
+
+// listVersions returns every version indexed for namespace/name.
+func (s *LocalStore) listVersions(namespace, name string) ([]*SchemaVersion, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	versions, ok := s.versions[fmt.Sprintf("%s.%s", namespace, name)]
+	if !ok {
+		return nil, false
+	}
+	out := make([]*SchemaVersion, len(versions))
+	copy(out, versions)
+	return out, true
+}
+
+// compareSemver compares two "major.minor.patch" version strings, returning
+// a negative, zero, or positive number as a < b, a == b, or a > b. Input
+// that doesn't parse as semver falls back to a lexical comparison.
+func compareSemver(a, b string) int {
+	var aMaj, aMin, aPatch, bMaj, bMin, bPatch int
+	if _, err := fmt.Sscanf(a, "%d.%d.%d", &aMaj, &aMin, &aPatch); err != nil {
+		return strings.Compare(a, b)
+	}
+	if _, err := fmt.Sscanf(b, "%d.%d.%d", &bMaj, &bMin, &bPatch); err != nil {
+		return strings.Compare(a, b)
+	}
+	switch {
+	case aMaj != bMaj:
+		return aMaj - bMaj
+	case aMin != bMin:
+		return aMin - bMin
+	default:
+		return aPatch - bPatch
+	}
+}
+
+// isOfflineFallbackError reports whether err is a server error (an HTTP 5xx
+// response or a transport failure), a timeout, or a cancellation (as opposed
+// to, say, a validation error) that warrants falling back to the local
+// schema bundle.
+func isOfflineFallbackError(err error) bool {
+	return errors.Is(err, ErrServerError) || errors.Is(err, ErrTimeout) || errors.Is(err, ErrCanceled)
+}
+
+// localValidate performs a minimal structural check against schema when the
+// client has fallen back to offline mode. Protobuf offline validation is
+// not yet supported, since it would need a full protobuf runtime this SDK
+// doesn't vendor.
+func localValidate(formatCheckers *formatCheckerRegistry, schema *GetSchemaResponse, dataStr string) (*ValidateResponse, error) {
+	switch schema.Format {
+	case SchemaFormatJSONSchema:
+		return localValidateJSONSchema(formatCheckers, schema, dataStr)
+	case SchemaFormatAvro:
+		return localValidateAvro(schema, dataStr)
+	default:
+		return nil, fmt.Errorf("offline validation is not supported for format %q", schema.Format)
+	}
+}
+
+// localValidateJSONSchema verifies that data parses as JSON, that every
+// field listed in the schema's "required" array is present, and that any
+// property with a "format" keyword matches the checker registered for that
+// format (see FormatChecker and Client.RegisterFormatChecker).
+func localValidateJSONSchema(formatCheckers *formatCheckerRegistry, schema *GetSchemaResponse, dataStr string) (*ValidateResponse, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(dataStr), &data); err != nil {
+		return &ValidateResponse{IsValid: false, Errors: []string{fmt.Sprintf("data is not valid JSON: %v", err)}}, nil
+	}
+
+	var def struct {
+		Required   []string `json:"required"`
+		Properties map[string]struct {
+			Format string `json:"format"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(schema.Content), &def); err != nil {
+		return nil, fmt.Errorf("failed to parse offline schema content: %w", err)
+	}
+
+	var errs []string
+	for _, field := range def.Required {
+		if _, ok := data[field]; !ok {
+			errs = append(errs, fmt.Sprintf("missing required field %q", field))
+		}
+	}
+
+	for field, prop := range def.Properties {
+		if prop.Format == "" {
+			continue
+		}
+		value, present := data[field]
+		if !present {
+			continue
+		}
+		checker, ok := formatCheckers.get(prop.Format)
+		if !ok {
+			continue
+		}
+		if !checker.IsFormat(value) {
+			errs = append(errs, fmt.Sprintf("field %q does not match format %q", field, prop.Format))
+		}
+	}
+
+	return &ValidateResponse{IsValid: len(errs) == 0, Errors: errs}, nil
+}
+
+// localValidateAvro verifies that data parses as JSON and that every
+// non-nullable field of the Avro record is present. A field typed as a
+// ["null", ...] union may be omitted.
+func localValidateAvro(schema *GetSchemaResponse, dataStr string) (*ValidateResponse, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(dataStr), &data); err != nil {
+		return &ValidateResponse{IsValid: false, Errors: []string{fmt.Sprintf("data is not valid JSON: %v", err)}}, nil
+	}
+
+	var avro avroSchema
+	if err := json.Unmarshal([]byte(schema.Content), &avro); err != nil {
+		return nil, fmt.Errorf("failed to parse offline avro schema: %w", err)
+	}
+
+	var errs []string
+	for _, field := range avro.Fields {
+		if isNullableAvroType(field.Type) {
+			continue
+		}
+		if _, ok := data[field.Name]; !ok {
+			errs = append(errs, fmt.Sprintf("missing required field %q", field.Name))
+		}
+	}
+
+	return &ValidateResponse{IsValid: len(errs) == 0, Errors: errs}, nil
+}
+
+// offlineFallbackByID returns the locally-indexed schema for schemaID if
+// the client has a LocalStore configured and isOfflineFallbackError accepts
+// err.
+func (c *Client) offlineFallbackByID(err error, schemaID string) (*GetSchemaResponse, bool) {
+	if c.localStore == nil || !isOfflineFallbackError(err) {
+		return nil, false
+	}
+	return c.localStore.getByID(schemaID)
+}
+
+// offlineFallbackByVersion returns the locally-indexed schema for
+// namespace/name/version if the client has a LocalStore configured and
+// isOfflineFallbackError accepts err.
+func (c *Client) offlineFallbackByVersion(err error, namespace, name, version string) (*GetSchemaResponse, bool) {
+	if c.localStore == nil || !isOfflineFallbackError(err) {
+		return nil, false
+	}
+	return c.localStore.getByVersion(namespace, name, version)
+}
+
+// offlineFallbackVersions returns the locally-indexed version list for
+// namespace/name if the client has a LocalStore configured and
+// isOfflineFallbackError accepts err.
+func (c *Client) offlineFallbackVersions(err error, namespace, name string) ([]*SchemaVersion, bool) {
+	if c.localStore == nil || !isOfflineFallbackError(err) {
+		return nil, false
+	}
+	return c.localStore.listVersions(namespace, name)
+}
+
+// offlineFallbackValidate validates dataStr against the locally-indexed
+// schema for schemaID if the client has a LocalStore configured and
+// isOfflineFallbackError accepts err.
+func (c *Client) offlineFallbackValidate(ctx context.Context, err error, schemaID, dataStr string) (*ValidateResponse, bool, error) {
+	if c.localStore == nil || !isOfflineFallbackError(err) {
+		return nil, false, nil
+	}
+	schema, ok := c.localStore.getByID(schemaID)
+	if !ok {
+		return nil, false, nil
+	}
+	resp, verr := localValidate(c.formatCheckers, schema, dataStr)
+	if verr != nil {
+		return nil, false, verr
+	}
+	return resp, true, nil
+}
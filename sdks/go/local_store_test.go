@@ -0,0 +1,215 @@
+package schema_registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeSchemaFile(t *testing.T, root, namespace, name, version string, resp *GetSchemaResponse) {
+	t.Helper()
+
+	dir := filepath.Join(root, namespace, name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("failed to create bundle dir: %v", err)
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("failed to marshal schema: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, version+".json"), data, 0o644); err != nil {
+		t.Fatalf("failed to write schema file: %v", err)
+	}
+}
+
+func TestGetSchemaFallsBackToLocalBundleOnServerError(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{
+		SchemaID:  "id-1",
+		Namespace: "ns",
+		Name:      "Widget",
+		Version:   "1.0.0",
+		Format:    SchemaFormatJSONSchema,
+		Content:   `{"type":"object"}`,
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.GetSchema(context.Background(), "id-1")
+	if err != nil {
+		t.Fatalf("GetSchema failed: %v", err)
+	}
+	if resp.Namespace != "ns" || resp.Name != "Widget" {
+		t.Errorf("expected locally-indexed schema, got %+v", resp)
+	}
+}
+
+func TestGetSchemaFallsBackToLocalBundleOnCallerContextDeadline(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{
+		SchemaID:  "id-1",
+		Namespace: "ns",
+		Name:      "Widget",
+		Version:   "1.0.0",
+		Format:    SchemaFormatJSONSchema,
+		Content:   `{"type":"object"}`,
+	})
+
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer server.Close()
+	defer close(block)
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+		RetryConfig:       &RetryConfig{MaxAttempts: 0, RetryableFunc: IsRetryable},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	resp, err := client.GetSchema(ctx, "id-1")
+	if err != nil {
+		t.Fatalf("expected a caller-context deadline to fall back to the local bundle, got error: %v", err)
+	}
+	if resp.Namespace != "ns" || resp.Name != "Widget" {
+		t.Errorf("expected locally-indexed schema, got %+v", resp)
+	}
+}
+
+func TestGetSchemaByVersionResolvesLatestFromBundle(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{SchemaID: "id-1", Format: SchemaFormatJSONSchema, Content: "{}"})
+	writeSchemaFile(t, dir, "ns", "Widget", "2.1.0", &GetSchemaResponse{SchemaID: "id-2", Format: SchemaFormatJSONSchema, Content: "{}"})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.GetSchemaByVersion(context.Background(), "ns", "Widget", "latest")
+	if err != nil {
+		t.Fatalf("GetSchemaByVersion failed: %v", err)
+	}
+	if resp.SchemaID != "id-2" {
+		t.Errorf("expected latest version id-2, got %q", resp.SchemaID)
+	}
+}
+
+func TestGetSchemaDoesNotFallBackWithoutOfflineSchemaDirs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.GetSchema(context.Background(), "id-1"); err == nil {
+		t.Error("expected error with no local bundle configured, got nil")
+	}
+}
+
+func TestValidateDataFallsBackToLocalValidation(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{
+		SchemaID: "id-1",
+		Format:   SchemaFormatJSONSchema,
+		Content:  `{"required":["name"]}`,
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	resp, err := client.ValidateData(context.Background(), "id-1", map[string]interface{}{"name": "widget"})
+	if err != nil {
+		t.Fatalf("ValidateData failed: %v", err)
+	}
+	if !resp.IsValid {
+		t.Errorf("expected valid data, got errors: %v", resp.Errors)
+	}
+
+	resp, err = client.ValidateData(context.Background(), "id-1", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("ValidateData failed: %v", err)
+	}
+	if resp.IsValid {
+		t.Error("expected invalid data for missing required field")
+	}
+}
+
+func TestListVersionsFallsBackToLocalBundle(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFile(t, dir, "ns", "Widget", "1.0.0", &GetSchemaResponse{SchemaID: "id-1", Format: SchemaFormatJSONSchema, Content: "{}"})
+	writeSchemaFile(t, dir, "ns", "Widget", "2.0.0", &GetSchemaResponse{SchemaID: "id-2", Format: SchemaFormatJSONSchema, Content: "{}"})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineSchemaDirs: []string{dir},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	versions, err := client.ListVersions(context.Background(), "ns", "Widget")
+	if err != nil {
+		t.Fatalf("ListVersions failed: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Errorf("expected 2 versions, got %d", len(versions))
+	}
+}
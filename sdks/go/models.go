@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 )
 
@@ -73,10 +74,29 @@ type Schema struct {
 	Format SchemaFormat `json:"format"`
 	// Content is the schema content (JSON/Avro/Protobuf).
 	Content string `json:"content"`
+	// References lists other registered schemas this one imports (e.g. a
+	// protobuf "import" or an Avro reference to another record). The
+	// client resolves these transitively before registration - see
+	// Client.RegisterSchema - and CheckCompatibility walks them to apply
+	// the compatibility mode across imports.
+	References []SchemaReference `json:"references,omitempty"`
 	// Metadata contains optional metadata.
 	Metadata *SchemaMetadata `json:"metadata,omitempty"`
 }
 
+// SchemaReference names another registered schema a Protobuf or Avro
+// schema imports.
+type SchemaReference struct {
+	// Name is the name used to import the reference (e.g. a .proto import
+	// path, or an Avro fully-qualified record name).
+	Name string `json:"name"`
+	// Subject is the referenced schema's subject, in the form
+	// "namespace.name" (see SubjectName's RecordNameStrategy).
+	Subject string `json:"subject"`
+	// Version is the referenced schema's version.
+	Version string `json:"version"`
+}
+
 var semverRegex = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
 
 // Validate validates the schema fields.
@@ -99,6 +119,24 @@ func (s *Schema) Validate() error {
 	if s.Content == "" {
 		return fmt.Errorf("content is required")
 	}
+
+	switch s.Format {
+	case SchemaFormatAvro:
+		if err := validateAvroSchema(s.Content); err != nil {
+			return fmt.Errorf("invalid avro schema: %w", err)
+		}
+	case SchemaFormatProtobuf:
+		// validateProtobufSource is a structural lint, not a protobuf
+		// compiler - it catches gross errors (unbalanced braces, no
+		// message/service) but a clean result here is not proof the
+		// schema actually compiles. See its doc comment.
+		errs := validateProtobufSource(s.Content)
+		errs = append(errs, validateProtobufReferences(s.Content, s.References)...)
+		if len(errs) > 0 {
+			return fmt.Errorf("protobuf schema failed structural lint: %s", strings.Join(errs, "; "))
+		}
+	}
+
 	return nil
 }
 
@@ -126,6 +164,9 @@ type GetSchemaResponse struct {
 	Format SchemaFormat `json:"format"`
 	// Content is the schema content.
 	Content string `json:"content"`
+	// References lists other registered schemas this one imports; see
+	// Schema.References.
+	References []SchemaReference `json:"references,omitempty"`
 	// Metadata contains optional metadata.
 	Metadata *SchemaMetadata `json:"metadata,omitempty"`
 	// CreatedAt is the creation timestamp.
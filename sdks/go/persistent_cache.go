@@ -0,0 +1,264 @@
+package schema_registry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStore is implemented by pluggable cache backends. Cache (the
+// in-memory LRU) and DiskStore both satisfy it, and they can be composed
+// via NewTwoTierStore.
+type CacheStore[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, value V)
+	Delete(key K)
+	Clear()
+	Len() int
+	Range(fn func(key K, value V) bool)
+}
+
+var (
+	_ CacheStore[string, string] = (*Cache[string, string])(nil)
+	_ CacheStore[string, string] = (*DiskStore[string, string])(nil)
+	_ CacheStore[string, string] = (*TwoTierStore[string, string])(nil)
+)
+
+// CacheBackend selects which CacheStore implementation a Client constructs
+// for itself when ClientConfig.CacheDir is set.
+type CacheBackend int
+
+const (
+	// CacheBackendMemory uses only the in-memory LRU cache (default).
+	CacheBackendMemory CacheBackend = iota
+	// CacheBackendDisk persists cached responses to CacheDir, with no
+	// in-memory tier.
+	CacheBackendDisk
+	// CacheBackendTwoTier fronts a disk-backed store with an in-memory LRU,
+	// writing evicted entries back to disk.
+	CacheBackendTwoTier
+)
+
+// diskEntry is the on-disk representation of a single DiskStore item.
+type diskEntry[K comparable, V any] struct {
+	Key       K         `json:"key"`
+	Value     V         `json:"value"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// DiskStore is a CacheStore backed by one JSON file per entry under Dir,
+// similar in spirit to kubeconform's on-disk schema cache. It survives
+// process restarts, so short-lived processes (CLIs, CI jobs, serverless
+// functions) can share a warm cache instead of re-fetching every schema.
+type DiskStore[K comparable, V any] struct {
+	dir string
+	ttl time.Duration
+	mu  sync.Mutex
+}
+
+// NewDiskStore creates a DiskStore rooted at dir, creating it if necessary.
+// ttl, if positive, expires entries that have not been refreshed within it.
+func NewDiskStore[K comparable, V any](dir string, ttl time.Duration) (*DiskStore[K, V], error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskStore[K, V]{dir: dir, ttl: ttl}, nil
+}
+
+// path returns the file path an entry for key is stored at.
+func (d *DiskStore[K, V]) path(key K) string {
+	sum := sha256.Sum256([]byte(fmt.Sprint(key)))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get retrieves a value from disk. I/O and expiry failures are treated as
+// cache misses, matching the in-memory Cache's behavior.
+func (d *DiskStore[K, V]) Get(key K) (V, bool) {
+	var zero V
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return zero, false
+	}
+
+	var entry diskEntry[K, V]
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return zero, false
+	}
+
+	if d.ttl > 0 && !entry.ExpiresAt.IsZero() && time.Now().After(entry.ExpiresAt) {
+		_ = os.Remove(d.path(key))
+		return zero, false
+	}
+
+	return entry.Value, true
+}
+
+// Set writes a value to disk, overwriting any existing entry for key.
+func (d *DiskStore[K, V]) Set(key K, value V) {
+	entry := diskEntry[K, V]{Key: key, Value: value}
+	if d.ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(d.ttl)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_ = os.WriteFile(d.path(key), data, 0o644)
+}
+
+// Delete removes key's entry from disk, if present.
+func (d *DiskStore[K, V]) Delete(key K) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_ = os.Remove(d.path(key))
+}
+
+// Clear removes every entry under Dir.
+func (d *DiskStore[K, V]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ".json") {
+			_ = os.Remove(filepath.Join(d.dir, e.Name()))
+		}
+	}
+}
+
+// Len returns the number of entries currently stored under Dir.
+func (d *DiskStore[K, V]) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return 0
+	}
+	count := 0
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ".json") {
+			count++
+		}
+	}
+	return count
+}
+
+// Range calls fn for every non-expired entry under Dir, in no particular order.
+func (d *DiskStore[K, V]) Range(fn func(key K, value V) bool) {
+	d.mu.Lock()
+	entries, err := os.ReadDir(d.dir)
+	d.mu.Unlock()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+
+		d.mu.Lock()
+		data, err := os.ReadFile(filepath.Join(d.dir, e.Name()))
+		d.mu.Unlock()
+		if err != nil {
+			continue
+		}
+
+		var entry diskEntry[K, V]
+		if err := json.Unmarshal(data, &entry); err != nil {
+			continue
+		}
+		if d.ttl > 0 && !entry.ExpiresAt.IsZero() && now.After(entry.ExpiresAt) {
+			continue
+		}
+
+		if !fn(entry.Key, entry.Value) {
+			return
+		}
+	}
+}
+
+// TwoTierStore fronts a backing CacheStore (typically a DiskStore) with an
+// in-memory LRU. Reads check the front tier first, falling back to and
+// promoting from the back tier on a miss; entries evicted from the front
+// tier are written back to the back tier so they aren't lost.
+type TwoTierStore[K comparable, V any] struct {
+	front *Cache[K, V]
+	back  CacheStore[K, V]
+}
+
+// NewTwoTierStore creates a TwoTierStore with an in-memory LRU of maxSize
+// entries and ttl in front of back.
+func NewTwoTierStore[K comparable, V any](maxSize int, ttl time.Duration, back CacheStore[K, V]) *TwoTierStore[K, V] {
+	t := &TwoTierStore[K, V]{back: back}
+	t.front = NewCache[K, V](maxSize, ttl, func(key K, value V) {
+		t.back.Set(key, value)
+	})
+	return t
+}
+
+// Get checks the in-memory tier first, then the backing store, promoting a
+// backing-store hit back into memory.
+func (t *TwoTierStore[K, V]) Get(key K) (V, bool) {
+	if v, ok := t.front.Get(key); ok {
+		return v, true
+	}
+	if v, ok := t.back.Get(key); ok {
+		t.front.Set(key, v)
+		return v, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set writes through to the in-memory tier; it reaches the backing store
+// when evicted from memory, or immediately via Delete/Clear below.
+func (t *TwoTierStore[K, V]) Set(key K, value V) {
+	t.front.Set(key, value)
+}
+
+// Delete removes key from both tiers.
+func (t *TwoTierStore[K, V]) Delete(key K) {
+	t.front.Delete(key)
+	t.back.Delete(key)
+}
+
+// Clear empties both tiers.
+func (t *TwoTierStore[K, V]) Clear() {
+	t.front.Clear()
+	t.back.Clear()
+}
+
+// Len returns the number of entries in the in-memory tier.
+func (t *TwoTierStore[K, V]) Len() int {
+	return t.front.Len()
+}
+
+// Range iterates the in-memory tier only.
+func (t *TwoTierStore[K, V]) Range(fn func(key K, value V) bool) {
+	t.front.Range(fn)
+}
+
+// Stats returns the in-memory tier's hit/miss/eviction counters.
+func (t *TwoTierStore[K, V]) Stats() CacheStats {
+	return t.front.Stats()
+}
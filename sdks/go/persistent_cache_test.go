@@ -0,0 +1,164 @@
+package schema_registry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiskStorePersistsAcrossRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewDiskStore[string, string](dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore failed: %v", err)
+	}
+	store.Set("a", "apple")
+	store.Set("b", "banana")
+
+	// Simulate a process restart by opening a fresh DiskStore over the same dir.
+	reopened, err := NewDiskStore[string, string](dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore (reopen) failed: %v", err)
+	}
+
+	if v, ok := reopened.Get("a"); !ok || v != "apple" {
+		t.Errorf("Get(a) = %q, %v; want apple, true", v, ok)
+	}
+	if v, ok := reopened.Get("b"); !ok || v != "banana" {
+		t.Errorf("Get(b) = %q, %v; want banana, true", v, ok)
+	}
+	if got := reopened.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+}
+
+func TestDiskStoreExpiresEntries(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewDiskStore[string, string](dir, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewDiskStore failed: %v", err)
+	}
+	store.Set("a", "apple")
+
+	time.Sleep(100 * time.Millisecond)
+
+	if _, ok := store.Get("a"); ok {
+		t.Error("expected expired entry to be a miss")
+	}
+}
+
+func TestDiskStoreDeleteAndClear(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewDiskStore[string, int](dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore failed: %v", err)
+	}
+	store.Set("a", 1)
+	store.Set("b", 2)
+
+	store.Delete("a")
+	if _, ok := store.Get("a"); ok {
+		t.Error("expected a to be deleted")
+	}
+	if got := store.Len(); got != 1 {
+		t.Errorf("Len() after Delete = %d, want 1", got)
+	}
+
+	store.Clear()
+	if got := store.Len(); got != 0 {
+		t.Errorf("Len() after Clear = %d, want 0", got)
+	}
+}
+
+func TestDiskStoreRange(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewDiskStore[string, int](dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore failed: %v", err)
+	}
+	want := map[string]int{"a": 1, "b": 2, "c": 3}
+	for k, v := range want {
+		store.Set(k, v)
+	}
+
+	got := map[string]int{}
+	store.Range(func(key string, value int) bool {
+		got[key] = value
+		return true
+	})
+
+	if len(got) != len(want) {
+		t.Fatalf("Range visited %d entries, want %d", len(got), len(want))
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("Range entry %q = %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+func TestTwoTierStoreWritesBackOnEviction(t *testing.T) {
+	dir := t.TempDir()
+
+	back, err := NewDiskStore[string, string](dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore failed: %v", err)
+	}
+
+	// maxSize 1 forces every additional Set to evict the previous entry.
+	store := NewTwoTierStore[string, string](1, 0, back)
+	store.Set("a", "apple")
+	store.Set("b", "banana")
+
+	if _, ok := back.Get("a"); !ok {
+		t.Error("expected evicted entry to have been written back to disk")
+	}
+
+	if v, ok := store.Get("a"); !ok || v != "apple" {
+		t.Errorf("Get(a) = %q, %v; want apple, true (promoted from disk)", v, ok)
+	}
+}
+
+func TestTwoTierStoreDeleteAndClear(t *testing.T) {
+	dir := t.TempDir()
+
+	back, err := NewDiskStore[string, string](dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore failed: %v", err)
+	}
+
+	store := NewTwoTierStore[string, string](10, 0, back)
+	store.Set("a", "apple")
+	store.Delete("a")
+
+	if _, ok := store.Get("a"); ok {
+		t.Error("expected a to be deleted from both tiers")
+	}
+
+	store.Set("b", "banana")
+	store.Clear()
+	if _, ok := store.Get("b"); ok {
+		t.Error("expected Clear to empty both tiers")
+	}
+}
+
+func TestClientCacheStatsFallsBackForUnsupportedBackend(t *testing.T) {
+	client, err := NewClient(ClientConfig{
+		BaseURL:      "http://example.invalid",
+		EnableCache:  true,
+		CacheBackend: CacheBackendDisk,
+		CacheDir:     t.TempDir(),
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stats := client.CacheStats()
+	if stats.Hits != 0 || stats.Misses != 0 || stats.Evictions != 0 {
+		t.Errorf("expected zero CacheStats for a disk-only backend, got hits=%d misses=%d evictions=%d", stats.Hits, stats.Misses, stats.Evictions)
+	}
+}
@@ -0,0 +1,149 @@
+package schema_registry
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimitConfig configures client-side request pacing, paced
+// independently per endpoint so a burst of calls (e.g. ValidateData in a
+// tight loop) doesn't hammer the server into returning 429s in the first
+// place.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained rate at which tokens refill.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests allowed to proceed
+	// back-to-back before pacing kicks in (default: 1).
+	Burst int
+}
+
+// requestStatsCounters tracks client-side rate-limiting counters.
+type requestStatsCounters struct {
+	throttled uint64
+	deferred  uint64
+}
+
+// addThrottled records a 429 response from the server.
+func (s *requestStatsCounters) addThrottled() {
+	atomic.AddUint64(&s.throttled, 1)
+}
+
+// addDeferred records that a request was delayed by the client-side rate
+// limiter before being sent.
+func (s *requestStatsCounters) addDeferred() {
+	atomic.AddUint64(&s.deferred, 1)
+}
+
+// RequestStats reports client-side request-pacing counters, analogous to
+// CacheStats for the cache layer.
+type RequestStats struct {
+	// Throttled is the number of requests that received a 429 response from
+	// the server, despite any client-side pacing.
+	Throttled uint64
+	// Deferred is the number of requests the client-side rate limiter
+	// delayed before sending, to stay within RateLimitConfig.
+	Deferred uint64
+}
+
+// tokenBucket is a thread-safe token-bucket rate limiter.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket creates a bucket that refills at rate tokens/second, up to
+// burst tokens, starting full.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst <= 0 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// refillLocked replenishes tokens based on elapsed time since the last call.
+// Callers must hold b.mu.
+func (b *tokenBucket) refillLocked() {
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+// wait blocks until a token is available or ctx is done. It reports whether
+// the caller was made to wait at all.
+func (b *tokenBucket) wait(ctx context.Context) (deferred bool, err error) {
+	for {
+		b.mu.Lock()
+		b.refillLocked()
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return deferred, nil
+		}
+
+		waitDuration := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		deferred = true
+		select {
+		case <-ctx.Done():
+			return deferred, ctx.Err()
+		case <-time.After(waitDuration):
+		}
+	}
+}
+
+// tryTake reports whether a token is available, consuming one if so. Unlike
+// wait, it never blocks - a caller with no token available gets false
+// immediately.
+func (b *tokenBucket) tryTake() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refillLocked()
+	if b.tokens >= 1 {
+		b.tokens--
+		return true
+	}
+	return false
+}
+
+// rateLimiterPool paces outgoing requests per endpoint, lazily creating a
+// tokenBucket the first time a given endpoint is seen.
+type rateLimiterPool struct {
+	mu      sync.Mutex
+	cfg     RateLimitConfig
+	buckets map[string]*tokenBucket
+}
+
+// newRateLimiterPool creates a rate limiter pool from cfg.
+func newRateLimiterPool(cfg RateLimitConfig) *rateLimiterPool {
+	return &rateLimiterPool{cfg: cfg, buckets: make(map[string]*tokenBucket)}
+}
+
+// wait paces a request against endpoint, blocking until it may proceed. It
+// reports whether the caller was delayed.
+func (p *rateLimiterPool) wait(ctx context.Context, endpoint string) (bool, error) {
+	p.mu.Lock()
+	b, ok := p.buckets[endpoint]
+	if !ok {
+		b = newTokenBucket(p.cfg.RequestsPerSecond, p.cfg.Burst)
+		p.buckets[endpoint] = b
+	}
+	p.mu.Unlock()
+
+	return b.wait(ctx)
+}
@@ -0,0 +1,135 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryHonorsRetryAfterHeader(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"id-1","namespace":"ns","name":"Widget","version":"1.0.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.GetSchema(context.Background(), "id-1"); err != nil {
+		t.Fatalf("GetSchema failed: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts (1 throttled + 1 success), got %d", got)
+	}
+}
+
+func TestRequestStatsTracksThrottledResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:     server.URL,
+		RetryConfig: &RetryConfig{MaxAttempts: 0, RetryableFunc: IsRetryable},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.GetSchema(context.Background(), "id-1"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	stats := client.RequestStats()
+	if stats.Throttled == 0 {
+		t.Error("expected RequestStats.Throttled to be non-zero")
+	}
+}
+
+func TestNewClientRejectsZeroRateLimit(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		BaseURL:   "http://example.invalid",
+		RateLimit: &RateLimitConfig{RequestsPerSecond: 0, Burst: 1},
+	})
+	if err == nil {
+		t.Fatal("expected NewClient to reject a zero RequestsPerSecond, got nil error")
+	}
+}
+
+func TestTokenBucketPacesRequests(t *testing.T) {
+	b := newTokenBucket(10, 1)
+
+	if deferred, err := b.wait(context.Background()); err != nil || deferred {
+		t.Fatalf("first call should proceed immediately, got deferred=%v err=%v", deferred, err)
+	}
+
+	start := time.Now()
+	deferred, err := b.wait(context.Background())
+	if err != nil {
+		t.Fatalf("wait failed: %v", err)
+	}
+	if !deferred {
+		t.Error("expected second call to be deferred")
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected a pacing delay, only waited %v", elapsed)
+	}
+}
+
+func TestRateLimitConfigPacesOutgoingRequests(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"id-1","namespace":"ns","name":"Widget","version":"1.0.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:     server.URL,
+		EnableCache: false,
+		RateLimit:   &RateLimitConfig{RequestsPerSecond: 20, Burst: 1},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetSchema(context.Background(), "id-1"); err != nil {
+			t.Fatalf("GetSchema failed: %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected pacing to space out 3 requests at 20/s, only took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+
+	stats := client.RequestStats()
+	if stats.Deferred == 0 {
+		t.Error("expected RequestStats.Deferred to be non-zero")
+	}
+}
@@ -0,0 +1,178 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RefreshResult is the outcome of a Client.RefreshSchemas call.
+type RefreshResult struct {
+	// Namespace is the namespace that was refreshed.
+	Namespace string `json:"namespace"`
+	// Refreshed lists the schema IDs that were fetched and cached.
+	Refreshed []string `json:"refreshed"`
+	// Errors maps schema ID to the error encountered recompiling it. A
+	// per-schema failure doesn't abort the rest of the refresh.
+	Errors map[string]string `json:"errors,omitempty"`
+	// HighWaterMark is the newest UpdatedAt seen across Refreshed, persisted
+	// as the "since" cutoff for this namespace's next RefreshSchemas call.
+	HighWaterMark time.Time `json:"high_water_mark"`
+}
+
+// RefreshSchemas fetches only the schemas in namespace whose UpdatedAt is
+// newer than the high-water mark recorded by the last RefreshSchemas call for
+// that namespace (the server is sent this as a "since" query parameter),
+// recompiles each one, and updates the cache in place. A schema that fails
+// recompilation is recorded in RefreshResult.Errors instead of aborting the
+// rest of the refresh.
+func (c *Client) RefreshSchemas(ctx context.Context, namespace string) (*RefreshResult, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	since := c.refreshMark(namespace)
+
+	params := url.Values{}
+	if !since.IsZero() {
+		params.Set("since", since.UTC().Format(time.RFC3339))
+	}
+	path := fmt.Sprintf("/api/v1/schemas/%s", namespace)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var schemas []*GetSchemaResponse
+	err := Retry(ctx, c.retryConfig, func() error {
+		return c.doRequest(ctx, "GET", path, nil, &schemas)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RefreshResult{Namespace: namespace, HighWaterMark: since}
+	for _, schema := range schemas {
+		if err := recompileSchema(schema); err != nil {
+			if result.Errors == nil {
+				result.Errors = make(map[string]string)
+			}
+			result.Errors[schema.SchemaID] = err.Error()
+			continue
+		}
+
+		if c.cache != nil {
+			c.cache.Set(fmt.Sprintf("schema:%s", schema.SchemaID), schema)
+		}
+		result.Refreshed = append(result.Refreshed, schema.SchemaID)
+		if schema.UpdatedAt.After(result.HighWaterMark) {
+			result.HighWaterMark = schema.UpdatedAt
+		}
+	}
+
+	c.setRefreshMark(namespace, result.HighWaterMark)
+	return result, nil
+}
+
+// recompileSchema re-runs the format-specific structural checks from
+// Schema.Validate against a freshly fetched schema, so a malformed entry in
+// the registry is caught per-schema rather than silently cached.
+func recompileSchema(schema *GetSchemaResponse) error {
+	switch schema.Format {
+	case SchemaFormatAvro:
+		return validateAvroSchema(schema.Content)
+	case SchemaFormatProtobuf:
+		errs := validateProtobufSource(schema.Content)
+		errs = append(errs, validateProtobufReferences(schema.Content, schema.References)...)
+		if len(errs) > 0 {
+			return fmt.Errorf("%s", strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// refreshMark returns the high-water mark recorded for namespace, or the
+// zero Time if RefreshSchemas has not been called for it yet.
+func (c *Client) refreshMark(namespace string) time.Time {
+	c.refreshMarksMu.Lock()
+	defer c.refreshMarksMu.Unlock()
+	return c.refreshMarks[namespace]
+}
+
+// setRefreshMark records t as the high-water mark for namespace, unless t is
+// the zero Time (meaning nothing was successfully refreshed).
+func (c *Client) setRefreshMark(namespace string, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	c.refreshMarksMu.Lock()
+	defer c.refreshMarksMu.Unlock()
+	if c.refreshMarks == nil {
+		c.refreshMarks = make(map[string]time.Time)
+	}
+	c.refreshMarks[namespace] = t
+}
+
+// GetSchemasBatch fetches multiple schemas by ID in a single round-trip,
+// serving any already-cached IDs locally. It returns a result map keyed by
+// schema ID alongside an error map for IDs that could not be fetched - a
+// failure for one ID does not prevent the others from being returned.
+func (c *Client) GetSchemasBatch(ctx context.Context, ids []string) (map[string]*GetSchemaResponse, map[string]error) {
+	results := make(map[string]*GetSchemaResponse, len(ids))
+	errs := make(map[string]error)
+
+	if err := c.checkClosed(); err != nil {
+		for _, id := range ids {
+			errs[id] = err
+		}
+		return results, errs
+	}
+
+	var uncached []string
+	for _, id := range ids {
+		if c.cache != nil {
+			if cached, ok := c.cache.Get(fmt.Sprintf("schema:%s", id)); ok {
+				results[id] = cached
+				continue
+			}
+		}
+		uncached = append(uncached, id)
+	}
+	if len(uncached) == 0 {
+		return results, errs
+	}
+
+	body, err := marshalJSON(map[string]interface{}{"schema_ids": uncached})
+	if err != nil {
+		for _, id := range uncached {
+			errs[id] = fmt.Errorf("failed to marshal request: %w", err)
+		}
+		return results, errs
+	}
+
+	var resp map[string]*GetSchemaResponse
+	err = Retry(ctx, c.retryConfig, func() error {
+		return c.doRequest(ctx, "POST", "/api/v1/schemas/batch", body, &resp)
+	})
+	if err != nil {
+		for _, id := range uncached {
+			errs[id] = err
+		}
+		return results, errs
+	}
+
+	for _, id := range uncached {
+		schema, ok := resp[id]
+		if !ok {
+			errs[id] = fmt.Errorf("schema %q not found in batch response", id)
+			continue
+		}
+		results[id] = schema
+		if c.cache != nil {
+			c.cache.Set(fmt.Sprintf("schema:%s", id), schema)
+		}
+	}
+
+	return results, errs
+}
@@ -0,0 +1,152 @@
+package schema_registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRefreshSchemasSendsHighWaterMarkOnSubsequentCalls(t *testing.T) {
+	var sinceParams []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sinceParams = append(sinceParams, r.URL.Query().Get("since"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"schema_id":"id-1","namespace":"ns","name":"Widget","version":"1.0.0","format":"json_schema","content":"{}","updated_at":%q}]`,
+			time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC).Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.RefreshSchemas(context.Background(), "ns"); err != nil {
+		t.Fatalf("first RefreshSchemas failed: %v", err)
+	}
+	if _, err := client.RefreshSchemas(context.Background(), "ns"); err != nil {
+		t.Fatalf("second RefreshSchemas failed: %v", err)
+	}
+
+	if len(sinceParams) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(sinceParams))
+	}
+	if sinceParams[0] != "" {
+		t.Errorf("expected no since param on first call, got %q", sinceParams[0])
+	}
+	if sinceParams[1] == "" {
+		t.Error("expected a since param on second call, got none")
+	}
+}
+
+func TestRefreshSchemasRecordsPerSchemaErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"schema_id":"good-1","namespace":"ns","name":"Good","version":"1.0.0","format":"avro","content":"{\"type\":\"record\",\"name\":\"Good\",\"fields\":[{\"name\":\"id\",\"type\":\"string\"}]}"},
+			{"schema_id":"bad-1","namespace":"ns","name":"Bad","version":"1.0.0","format":"avro","content":"{\"type\":\"record\"}"}
+		]`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	result, err := client.RefreshSchemas(context.Background(), "ns")
+	if err != nil {
+		t.Fatalf("RefreshSchemas failed: %v", err)
+	}
+
+	if len(result.Refreshed) != 1 || result.Refreshed[0] != "good-1" {
+		t.Errorf("expected only good-1 to be refreshed, got %v", result.Refreshed)
+	}
+	if _, ok := result.Errors["bad-1"]; !ok {
+		t.Errorf("expected an error recorded for bad-1, got %v", result.Errors)
+	}
+
+	if cached, ok := client.cache.Get("schema:good-1"); !ok || cached.SchemaID != "good-1" {
+		t.Error("expected good-1 to be cached after refresh")
+	}
+	if _, ok := client.cache.Get("schema:bad-1"); ok {
+		t.Error("expected bad-1 not to be cached after a recompile error")
+	}
+}
+
+func TestGetSchemasBatchFetchesUncachedAndServesCached(t *testing.T) {
+	var batchCalls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		batchCalls++
+		var req struct {
+			SchemaIDs []string `json:"schema_ids"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		resp := make(map[string]*GetSchemaResponse)
+		for _, id := range req.SchemaIDs {
+			resp[id] = &GetSchemaResponse{SchemaID: id, Namespace: "ns", Name: "Widget", Version: "1.0.0", Format: SchemaFormatJSONSchema, Content: "{}"}
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	results, errs := client.GetSchemasBatch(context.Background(), []string{"id-1", "id-2"})
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if batchCalls != 1 {
+		t.Fatalf("expected 1 batch call, got %d", batchCalls)
+	}
+
+	results, errs = client.GetSchemasBatch(context.Background(), []string{"id-1", "id-2"})
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors on second call: %v", errs)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results on second call, got %d", len(results))
+	}
+	if batchCalls != 1 {
+		t.Errorf("expected cached IDs to skip the batch call, got %d total calls", batchCalls)
+	}
+}
+
+func TestGetSchemasBatchPartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id-1":{"schema_id":"id-1","namespace":"ns","name":"Widget","version":"1.0.0","format":"json_schema","content":"{}"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	results, errs := client.GetSchemasBatch(context.Background(), []string{"id-1", "id-missing"})
+	if _, ok := results["id-1"]; !ok {
+		t.Error("expected id-1 to be present in results")
+	}
+	if _, ok := errs["id-missing"]; !ok {
+		t.Errorf("expected an error for id-missing, got %v", errs)
+	}
+}
@@ -2,8 +2,10 @@ package schema_registry
 
 import (
 	"context"
+	"errors"
 	"math"
 	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -19,8 +21,95 @@ type RetryConfig struct {
 	BackoffMultiplier float64
 	// Jitter adds randomness to backoff to prevent thundering herd.
 	Jitter bool
-	// RetryableFunc determines if an error is retryable.
+	// RetryableFunc determines if an error is retryable. Ignored if Policy
+	// is set.
 	RetryableFunc func(error) bool
+	// Policy, if set, takes precedence over RetryableFunc. It classifies an
+	// error into a RetryDecision instead of a plain bool, so e.g. a 429 can
+	// honor its Retry-After via BackoffOverride, a 5xx can fall back to
+	// exponential backoff, and a 4xx can be marked Terminal.
+	Policy PolicyFunc
+	// Budget, if set, caps the rate of retries (not initial attempts) across
+	// every call sharing this RetryConfig, so a sustained outage doesn't
+	// turn every caller's MaxAttempts into a hammering loop. Nil disables
+	// budget enforcement (the default).
+	Budget *RetryBudget
+	// CircuitBreaker, if set, trips a per-endpoint circuit breaker once
+	// FailureRatio of the trailing MinRequests requests to an endpoint have
+	// failed, short-circuiting further requests to ErrCircuitOpen until
+	// Cooldown elapses, then probing with a single half-open request. Nil
+	// disables the breaker (the default). Enforced by Client at the
+	// transport layer, not by Retry/RetryWithResult themselves, since only
+	// the transport knows which endpoint a given attempt targets.
+	CircuitBreaker *CircuitBreakerConfig
+	// Strategy computes the delay between retries, overriding
+	// MaxBackoff/BackoffMultiplier/Jitter; InitialBackoff is only passed to
+	// Strategy as prev on its first call. Nil falls back to
+	// the original additive-jitter exponential math (equivalent to
+	// ExponentialJitter) for backward compatibility. A BackoffOverride from
+	// Policy, or a Retry-After header, both still take precedence over
+	// Strategy.
+	Strategy BackoffStrategy
+}
+
+// RetryDecision is returned by a PolicyFunc to control retry behavior for a
+// specific error, giving richer control than RetryableFunc's plain bool.
+type RetryDecision struct {
+	// Retry indicates whether the call should be retried.
+	Retry bool
+	// BackoffOverride, if positive, is used as the wait duration instead of
+	// the computed exponential backoff or a Retry-After header - e.g. a
+	// policy that parses its own rate-limit header.
+	BackoffOverride time.Duration
+	// Terminal marks the error as final: retrying stops immediately,
+	// regardless of Retry.
+	Terminal bool
+}
+
+// PolicyFunc classifies an error into a RetryDecision. See
+// RetryConfig.Policy.
+type PolicyFunc func(error) RetryDecision
+
+// RetryBudget caps the rate of retries (not initial attempts) via a token
+// bucket: TokensPerSecond replenishes the budget and Burst caps how many
+// retries may happen back-to-back. Attach the same *RetryBudget to a
+// RetryConfig shared across goroutines (e.g. Client.retryConfig) so they
+// draw from one budget instead of each independently exhausting
+// MaxAttempts during a sustained outage.
+type RetryBudget struct {
+	// TokensPerSecond is the sustained rate at which retry tokens refill.
+	TokensPerSecond float64
+	// Burst is the maximum number of retries allowed back-to-back before
+	// the budget is exhausted (default: 1).
+	Burst int
+
+	once   sync.Once
+	bucket *tokenBucket
+}
+
+// take reports whether a retry token is available, consuming one if so. The
+// underlying token bucket is created lazily on first use.
+func (b *RetryBudget) take() bool {
+	b.once.Do(func() {
+		b.bucket = newTokenBucket(b.TokensPerSecond, b.Burst)
+	})
+	return b.bucket.tryTake()
+}
+
+// decide determines whether err should be retried and any backoff override,
+// consulting Policy if set and falling back to RetryableFunc otherwise.
+func (cfg RetryConfig) decide(err error) (retry bool, backoffOverride time.Duration) {
+	if cfg.Policy != nil {
+		d := cfg.Policy(err)
+		if d.Terminal {
+			return false, 0
+		}
+		return d.Retry, d.BackoffOverride
+	}
+	if cfg.RetryableFunc != nil {
+		return cfg.RetryableFunc(err), 0
+	}
+	return false, 0
 }
 
 // DefaultRetryConfig returns the default retry configuration.
@@ -56,9 +145,28 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 		lastErr = err
 
 		// Check if we should retry
-		if attempt < cfg.MaxAttempts && cfg.RetryableFunc(err) {
-			// Calculate backoff duration
-			waitDuration := calculateBackoff(backoff, cfg.MaxBackoff, cfg.Jitter)
+		retry, backoffOverride := cfg.decide(err)
+		if attempt < cfg.MaxAttempts && retry {
+			if cfg.Budget != nil && !cfg.Budget.take() {
+				// Retry budget exhausted - stop hammering the server.
+				break
+			}
+
+			// A BackoffOverride from a PolicyFunc, or a 429's Retry-After
+			// header, takes precedence over Strategy/our own exponential
+			// backoff.
+			waitDuration := backoffOverride
+			if waitDuration <= 0 {
+				if d, ok := retryAfterDuration(err); ok {
+					waitDuration = d
+				} else if cfg.Strategy != nil {
+					waitDuration = cfg.Strategy.NextDelay(attempt, backoff)
+					backoff = waitDuration
+				} else {
+					waitDuration = calculateBackoff(backoff, cfg.MaxBackoff, cfg.Jitter)
+					backoff = time.Duration(float64(backoff) * cfg.BackoffMultiplier)
+				}
+			}
 
 			// Wait with context cancellation support
 			select {
@@ -67,9 +175,6 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 			case <-time.After(waitDuration):
 				// Continue to next attempt
 			}
-
-			// Increase backoff for next iteration
-			backoff = time.Duration(float64(backoff) * cfg.BackoffMultiplier)
 		} else {
 			// Don't retry
 			break
@@ -79,6 +184,16 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 	return lastErr
 }
 
+// retryAfterDuration reports the server-suggested wait time if err is (or
+// wraps) a RateLimitError with a Retry-After value.
+func retryAfterDuration(err error) (time.Duration, bool) {
+	var rle *RateLimitError
+	if !errors.As(err, &rle) {
+		return 0, false
+	}
+	return rle.RetryAfterDuration()
+}
+
 // RetryWithResult executes a function with exponential backoff retry logic and returns a result.
 // This uses generics to support any return type.
 func RetryWithResult[T any](ctx context.Context, cfg RetryConfig, fn func() (T, error)) (T, error) {
@@ -103,9 +218,28 @@ func RetryWithResult[T any](ctx context.Context, cfg RetryConfig, fn func() (T,
 		lastErr = err
 
 		// Check if we should retry
-		if attempt < cfg.MaxAttempts && cfg.RetryableFunc(err) {
-			// Calculate backoff duration
-			waitDuration := calculateBackoff(backoff, cfg.MaxBackoff, cfg.Jitter)
+		retry, backoffOverride := cfg.decide(err)
+		if attempt < cfg.MaxAttempts && retry {
+			if cfg.Budget != nil && !cfg.Budget.take() {
+				// Retry budget exhausted - stop hammering the server.
+				break
+			}
+
+			// A BackoffOverride from a PolicyFunc, or a 429's Retry-After
+			// header, takes precedence over Strategy/our own exponential
+			// backoff.
+			waitDuration := backoffOverride
+			if waitDuration <= 0 {
+				if d, ok := retryAfterDuration(err); ok {
+					waitDuration = d
+				} else if cfg.Strategy != nil {
+					waitDuration = cfg.Strategy.NextDelay(attempt, backoff)
+					backoff = waitDuration
+				} else {
+					waitDuration = calculateBackoff(backoff, cfg.MaxBackoff, cfg.Jitter)
+					backoff = time.Duration(float64(backoff) * cfg.BackoffMultiplier)
+				}
+			}
 
 			// Wait with context cancellation support
 			select {
@@ -114,9 +248,6 @@ func RetryWithResult[T any](ctx context.Context, cfg RetryConfig, fn func() (T,
 			case <-time.After(waitDuration):
 				// Continue to next attempt
 			}
-
-			// Increase backoff for next iteration
-			backoff = time.Duration(float64(backoff) * cfg.BackoffMultiplier)
 		} else {
 			// Don't retry
 			break
@@ -169,6 +300,8 @@ type Backoff struct {
 	maxBackoff        time.Duration
 	backoffMultiplier float64
 	jitter            bool
+	strategy          BackoffStrategy
+	prevDelay         time.Duration
 }
 
 // NewBackoff creates a new Backoff instance.
@@ -182,8 +315,23 @@ func NewBackoff(initialBackoff, maxBackoff time.Duration, jitter bool) *Backoff
 	}
 }
 
+// SetStrategy configures the BackoffStrategy Next() delegates to - e.g.
+// FullJitter or DecorrelatedJitter for a long-running worker that wants to
+// avoid synchronized retry storms. Nil (the default) keeps the original
+// additive-jitter exponential math.
+func (b *Backoff) SetStrategy(strategy BackoffStrategy) {
+	b.strategy = strategy
+}
+
 // Next returns the next backoff duration and increments the attempt counter.
 func (b *Backoff) Next() time.Duration {
+	if b.strategy != nil {
+		delay := b.strategy.NextDelay(b.attempt, b.prevDelay)
+		b.attempt++
+		b.prevDelay = delay
+		return delay
+	}
+
 	backoff := time.Duration(float64(b.initialBackoff) * math.Pow(b.backoffMultiplier, float64(b.attempt)))
 	if backoff > b.maxBackoff {
 		backoff = b.maxBackoff
@@ -201,6 +349,7 @@ func (b *Backoff) Next() time.Duration {
 // Reset resets the backoff to the initial state.
 func (b *Backoff) Reset() {
 	b.attempt = 0
+	b.prevDelay = 0
 }
 
 // Attempt returns the current attempt number.
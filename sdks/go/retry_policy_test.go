@@ -0,0 +1,117 @@
+package schema_registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyFuncOverridesRetryableFunc(t *testing.T) {
+	cfg := RetryConfig{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        10 * time.Millisecond,
+		BackoffMultiplier: 2.0,
+		RetryableFunc:     func(error) bool { return true }, // would retry up to MaxAttempts times if consulted
+		Policy: func(err error) RetryDecision {
+			return RetryDecision{Terminal: true}
+		},
+	}
+
+	attempts := 0
+	err := Retry(context.Background(), cfg, func() error {
+		attempts++
+		return errors.New("boom")
+	})
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected exactly 1 attempt since Policy marked the error Terminal, got %d", attempts)
+	}
+}
+
+func TestRetryPolicyBackoffOverride(t *testing.T) {
+	cfg := RetryConfig{
+		MaxAttempts:       1,
+		InitialBackoff:    time.Hour, // would block the test if honored
+		MaxBackoff:        time.Hour,
+		BackoffMultiplier: 2.0,
+		Policy: func(err error) RetryDecision {
+			return RetryDecision{Retry: true, BackoffOverride: time.Millisecond}
+		},
+	}
+
+	attempts := 0
+	start := time.Now()
+	_ = Retry(context.Background(), cfg, func() error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected BackoffOverride to be honored instead of InitialBackoff, took %v", elapsed)
+	}
+}
+
+func TestRetryBudgetStopsRetryingOnceExhausted(t *testing.T) {
+	budget := &RetryBudget{TokensPerSecond: 0, Burst: 1}
+
+	cfg := RetryConfig{
+		MaxAttempts:       5,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        10 * time.Millisecond,
+		BackoffMultiplier: 2.0,
+		RetryableFunc:     func(error) bool { return true },
+		Budget:            budget,
+	}
+
+	attempts := 0
+	_ = Retry(context.Background(), cfg, func() error {
+		attempts++
+		return errors.New("boom")
+	})
+
+	// Burst: 1 allows exactly one retry (two total attempts) before the
+	// zero-refill-rate budget is exhausted.
+	if attempts != 2 {
+		t.Errorf("expected the retry budget to cap attempts at 2, got %d", attempts)
+	}
+}
+
+func TestRetryBudgetSharedAcrossCalls(t *testing.T) {
+	budget := &RetryBudget{TokensPerSecond: 0, Burst: 1}
+	cfg := RetryConfig{
+		MaxAttempts:       5,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        10 * time.Millisecond,
+		BackoffMultiplier: 2.0,
+		RetryableFunc:     func(error) bool { return true },
+		Budget:            budget,
+	}
+
+	var firstAttempts, secondAttempts int
+	_ = Retry(context.Background(), cfg, func() error {
+		firstAttempts++
+		return errors.New("boom")
+	})
+	_ = Retry(context.Background(), cfg, func() error {
+		secondAttempts++
+		return errors.New("boom")
+	})
+
+	// Each call always gets its own initial attempt (1 each); the shared
+	// budget's single token allows exactly one of the two calls one retry.
+	total := firstAttempts + secondAttempts
+	if total != 3 {
+		t.Errorf("expected the shared budget to allow exactly 1 retry total across both calls (3 attempts), got %d (first=%d, second=%d)", total, firstAttempts, secondAttempts)
+	}
+}
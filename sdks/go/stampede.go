@@ -0,0 +1,168 @@
+package schema_registry
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// clientStats tracks counters for cache-stampede coordination that the
+// cache backend itself has no visibility into.
+type clientStats struct {
+	coalesced        uint64
+	refreshConflicts uint64
+}
+
+// addCoalesced records that a caller shared a result with an in-flight or
+// already-locked fetch instead of triggering its own.
+func (s *clientStats) addCoalesced() {
+	atomic.AddUint64(&s.coalesced, 1)
+}
+
+// addRefreshConflict records that RefreshLock.Lock failed or could not be
+// acquired, so the fetch proceeded without cross-process coordination.
+func (s *clientStats) addRefreshConflict() {
+	atomic.AddUint64(&s.refreshConflicts, 1)
+}
+
+// RefreshLock coordinates cache refresh across a fleet of clients pointed at
+// the same registry, so that when several processes miss the same cache key
+// at once, only one of them performs the underlying fetch. The default
+// implementation (mutexRefreshLock) only coordinates goroutines within a
+// single process; plug in a distributed implementation (e.g. backed by
+// Redis, similar to MinIO's distributed locker) to coordinate across
+// processes sharing a disk-backed cache.
+type RefreshLock interface {
+	// Lock acquires the refresh lock for key, blocking until it is available
+	// or ctx is done. It returns a function that releases the lock.
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
+
+// mutexRefreshLock is the default RefreshLock: a per-key mutex that only
+// coordinates goroutines within this process.
+type mutexRefreshLock struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// newMutexRefreshLock creates the default in-process RefreshLock.
+func newMutexRefreshLock() *mutexRefreshLock {
+	return &mutexRefreshLock{locks: make(map[string]*sync.Mutex)}
+}
+
+// Lock implements RefreshLock.
+func (m *mutexRefreshLock) Lock(ctx context.Context, key string) (func(), error) {
+	m.mu.Lock()
+	keyLock, ok := m.locks[key]
+	if !ok {
+		keyLock = &sync.Mutex{}
+		m.locks[key] = keyLock
+	}
+	m.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		keyLock.Lock()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return keyLock.Unlock, nil
+	case <-ctx.Done():
+		go func() {
+			<-done
+			keyLock.Unlock()
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// inflightFetch tracks a single in-progress fetch shared by every caller
+// coalesced onto it.
+type inflightFetch struct {
+	wg  sync.WaitGroup
+	val *GetSchemaResponse
+	err error
+}
+
+// callGroup coalesces concurrent fetches for the same key into a single
+// call, singleflight-style, so a cache miss fanned out across many
+// goroutines results in exactly one HTTP request.
+type callGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightFetch
+}
+
+// newCallGroup creates an empty callGroup.
+func newCallGroup() *callGroup {
+	return &callGroup{calls: make(map[string]*inflightFetch)}
+}
+
+// do runs fetch for key, or waits for and shares the result of an
+// already-in-flight call for the same key. The returned bool reports
+// whether the result was shared with another caller rather than fetched by
+// this call.
+func (g *callGroup) do(key string, fetch func() (*GetSchemaResponse, error)) (*GetSchemaResponse, error, bool) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.val, call.err, true
+	}
+
+	call := &inflightFetch{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.val, call.err = fetch()
+	call.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return call.val, call.err, false
+}
+
+// fetchWithCoalescing serves cacheKey from cache if present. On a miss, it
+// coordinates concurrent fetchers so only one underlying fetch happens per
+// key: in-process callers are coalesced through a singleflight callGroup,
+// and cross-process callers are coordinated through c.refreshLock (useful
+// when a fleet of clients share a disk-backed cache). The fetched result is
+// cached on success.
+func (c *Client) fetchWithCoalescing(ctx context.Context, cacheKey string, fetch func() (*GetSchemaResponse, error)) (*GetSchemaResponse, error) {
+	if c.cache == nil {
+		return fetch()
+	}
+
+	if cached, ok := c.cache.Get(cacheKey); ok {
+		return cached, nil
+	}
+
+	unlock, err := c.refreshLock.Lock(ctx, cacheKey)
+	if err != nil {
+		c.stats.addRefreshConflict()
+	} else {
+		defer unlock()
+
+		// Re-check the cache now that we hold the lock: another process may
+		// have already refreshed this key while we were waiting.
+		if cached, ok := c.cache.Get(cacheKey); ok {
+			c.stats.addCoalesced()
+			return cached, nil
+		}
+	}
+
+	resp, ferr, shared := c.singleflight.do(cacheKey, fetch)
+	if shared {
+		c.stats.addCoalesced()
+	}
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	c.cache.Set(cacheKey, resp)
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetSchemaCoalescesConcurrentMisses(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"id-1","namespace":"ns","name":"Widget","version":"1.0.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, EnableCache: true})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = client.GetSchema(context.Background(), "id-1")
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("GetSchema[%d] failed: %v", i, err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("expected exactly 1 HTTP request for %d concurrent callers, got %d", n, got)
+	}
+
+	stats := client.CacheStats()
+	if stats.Coalesced == 0 {
+		t.Error("expected CacheStats.Coalesced to be non-zero")
+	}
+}
+
+func TestCacheStatsTracksRefreshConflicts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"schema_id":"id-1","namespace":"ns","name":"Widget","version":"1.0.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		BaseURL:     server.URL,
+		EnableCache: true,
+		RefreshLock: failingRefreshLock{},
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.GetSchema(context.Background(), "id-1"); err != nil {
+		t.Fatalf("GetSchema failed: %v", err)
+	}
+
+	stats := client.CacheStats()
+	if stats.RefreshConflicts == 0 {
+		t.Error("expected CacheStats.RefreshConflicts to be non-zero")
+	}
+}
+
+// failingRefreshLock always fails to acquire, exercising the fallback path
+// where a fetch proceeds without cross-process coordination.
+type failingRefreshLock struct{}
+
+func (failingRefreshLock) Lock(ctx context.Context, key string) (func(), error) {
+	return nil, fmt.Errorf("lock unavailable")
+}
@@ -0,0 +1,305 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// SubjectNamingStrategy selects how a schema's namespace/name identity maps
+// onto a Confluent-style subject name, mirroring the strategies supported by
+// Confluent's Kafka serializers.
+type SubjectNamingStrategy int
+
+const (
+	// TopicNameStrategy names the subject after the topic alone:
+	// "<topic>-key" or "<topic>-value". This is Confluent's default, and
+	// does not preserve namespace/name identity in the subject name.
+	TopicNameStrategy SubjectNamingStrategy = iota
+	// RecordNameStrategy names the subject after the schema's fully
+	// qualified record name, "<namespace>.<name>", independent of topic.
+	// It is the only strategy SubjectIdentity can reverse without extra
+	// context.
+	RecordNameStrategy
+	// TopicRecordNameStrategy combines both: "<topic>-<namespace>.<name>".
+	TopicRecordNameStrategy
+)
+
+// SubjectName computes the Confluent subject name for namespace/name under
+// strategy. topic is required for TopicNameStrategy and
+// TopicRecordNameStrategy, and ignored by RecordNameStrategy. isKey selects
+// the "-key" or "-value" suffix Confluent uses for key vs. value schemas.
+func SubjectName(strategy SubjectNamingStrategy, topic, namespace, name string, isKey bool) (string, error) {
+	suffix := "value"
+	if isKey {
+		suffix = "key"
+	}
+
+	switch strategy {
+	case RecordNameStrategy:
+		return namespace + "." + name, nil
+	case TopicNameStrategy:
+		if topic == "" {
+			return "", fmt.Errorf("topic is required for TopicNameStrategy")
+		}
+		return topic + "-" + suffix, nil
+	case TopicRecordNameStrategy:
+		if topic == "" {
+			return "", fmt.Errorf("topic is required for TopicRecordNameStrategy")
+		}
+		return topic + "-" + namespace + "." + name, nil
+	default:
+		return "", fmt.Errorf("unknown subject naming strategy: %d", strategy)
+	}
+}
+
+// SubjectIdentity recovers the namespace/name pair from a subject produced
+// by RecordNameStrategy. TopicNameStrategy and TopicRecordNameStrategy
+// subjects embed the topic, not the namespace/name pair, so they can't be
+// reversed from the subject name alone; callers using those strategies must
+// track the mapping themselves.
+func SubjectIdentity(subject string) (namespace, name string, err error) {
+	return splitSubject(subject)
+}
+
+// confluentSchemaPayload is the request body for POST
+// /subjects/{subject}/versions and POST
+// /compatibility/subjects/{subject}/versions/{version}.
+type confluentSchemaPayload struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+// confluentRegisterResponse is the response body for POST
+// /subjects/{subject}/versions. SchemaID is not part of the real Confluent
+// wire format; this registry's Confluent-compatible mode includes it
+// alongside the integer ID so SubjectClient can keep its bidirectional
+// cache in sync without a second round-trip through GetSchema.
+type confluentRegisterResponse struct {
+	ID       int32  `json:"id"`
+	SchemaID string `json:"schema_id,omitempty"`
+}
+
+// confluentSchemaByIDResponse is the response body for GET
+// /schemas/ids/{id}.
+type confluentSchemaByIDResponse struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+	SchemaID   string `json:"schema_id,omitempty"`
+}
+
+// confluentCompatibilityResponse is the response body for POST
+// /compatibility/subjects/{subject}/versions/{version}.
+type confluentCompatibilityResponse struct {
+	IsCompatible bool `json:"is_compatible"`
+}
+
+// confluentConfigUpdate is the request body for PUT /config/{subject}.
+type confluentConfigUpdate struct {
+	Compatibility string `json:"compatibility"`
+}
+
+// confluentSchemaType maps a SchemaFormat onto Confluent's schemaType
+// string.
+func confluentSchemaType(f SchemaFormat) string {
+	switch f {
+	case SchemaFormatAvro:
+		return "AVRO"
+	case SchemaFormatProtobuf:
+		return "PROTOBUF"
+	default:
+		return "JSON"
+	}
+}
+
+// schemaFormatFromConfluentType is the inverse of confluentSchemaType,
+// defaulting to SchemaFormatJSONSchema for an empty or unrecognized type.
+func schemaFormatFromConfluentType(t string) SchemaFormat {
+	switch strings.ToUpper(t) {
+	case "AVRO":
+		return SchemaFormatAvro
+	case "PROTOBUF":
+		return SchemaFormatProtobuf
+	default:
+		return SchemaFormatJSONSchema
+	}
+}
+
+// SubjectClient exposes a Confluent Schema Registry-compatible REST surface
+// - subjects, versions, integer schema IDs, compatibility checks, and
+// per-subject config - on top of Client, so existing Kafka tooling built
+// against Confluent's client can target this registry unchanged. Confluent
+// tooling expects small integer schema IDs; this registry identifies
+// schemas by UUID, so SubjectClient keeps a local bidirectional cache
+// translating between the two, seeded from the schema_id field every
+// Confluent-shaped response carries alongside its integer id.
+//
+// SubjectClient reuses Client's transport, retry, credentials, and signing,
+// so Confluent-style Basic or Bearer auth (e.g. BasicAuth, BearerToken) set
+// as ClientConfig.Credentials applies to SubjectClient calls exactly as it
+// does to the rest of the API - there's no separate auth path to configure.
+type SubjectClient struct {
+	client   *Client
+	Strategy SubjectNamingStrategy
+
+	mu       sync.RWMutex
+	idToUUID map[int32]string
+	uuidToID map[string]int32
+}
+
+// NewSubjectClient creates a SubjectClient backed by client, naming subjects
+// according to strategy.
+func NewSubjectClient(client *Client, strategy SubjectNamingStrategy) *SubjectClient {
+	return &SubjectClient{
+		client:   client,
+		Strategy: strategy,
+		idToUUID: make(map[int32]string),
+		uuidToID: make(map[string]int32),
+	}
+}
+
+// remember records the id <-> schemaID mapping so later SchemaUUID calls
+// can translate an integer ID to its UUID without a round-trip.
+func (s *SubjectClient) remember(id int32, schemaID string) {
+	if schemaID == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.idToUUID[id] = schemaID
+	s.uuidToID[schemaID] = id
+}
+
+// SchemaUUID translates a Confluent-compatible integer schema ID into this
+// registry's native UUID, if it has been observed by a prior RegisterVersion
+// or SchemaByID call on this SubjectClient.
+func (s *SubjectClient) SchemaUUID(id int32) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	uuid, ok := s.idToUUID[id]
+	return uuid, ok
+}
+
+// ListSubjects returns all registered subject names (GET /subjects).
+func (s *SubjectClient) ListSubjects(ctx context.Context) ([]string, error) {
+	if err := s.client.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	var subjects []string
+	err := Retry(ctx, s.client.retryConfig, func() error {
+		return s.client.doRequest(ctx, "GET", "/subjects", nil, &subjects)
+	})
+	return subjects, err
+}
+
+// ListSubjectVersions returns the versions registered under subject, as
+// Confluent's sequential integers (GET /subjects/{subject}/versions).
+func (s *SubjectClient) ListSubjectVersions(ctx context.Context, subject string) ([]int, error) {
+	if err := s.client.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	var versions []int
+	path := fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject))
+	err := Retry(ctx, s.client.retryConfig, func() error {
+		return s.client.doRequest(ctx, "GET", path, nil, &versions)
+	})
+	return versions, err
+}
+
+// RegisterVersion registers a new schema version under subject (POST
+// /subjects/{subject}/versions) and returns its Confluent-compatible
+// integer ID.
+func (s *SubjectClient) RegisterVersion(ctx context.Context, subject, schemaContent string, format SchemaFormat) (int32, error) {
+	if err := s.client.checkClosed(); err != nil {
+		return 0, err
+	}
+
+	body, err := marshalJSON(confluentSchemaPayload{Schema: schemaContent, SchemaType: confluentSchemaType(format)})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal schema payload: %w", err)
+	}
+
+	var resp confluentRegisterResponse
+	path := fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject))
+	if err := Retry(ctx, s.client.retryConfig, func() error {
+		return s.client.doRequest(ctx, "POST", path, body, &resp)
+	}); err != nil {
+		return 0, err
+	}
+
+	s.remember(resp.ID, resp.SchemaID)
+	return resp.ID, nil
+}
+
+// SchemaByID retrieves the schema registered under id (GET
+// /schemas/ids/{id}), translated into the registry's own GetSchemaResponse
+// type.
+func (s *SubjectClient) SchemaByID(ctx context.Context, id int32) (*GetSchemaResponse, error) {
+	if err := s.client.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	var resp confluentSchemaByIDResponse
+	path := fmt.Sprintf("/schemas/ids/%d", id)
+	if err := Retry(ctx, s.client.retryConfig, func() error {
+		return s.client.doRequest(ctx, "GET", path, nil, &resp)
+	}); err != nil {
+		return nil, err
+	}
+
+	s.remember(id, resp.SchemaID)
+
+	return &GetSchemaResponse{
+		SchemaID: resp.SchemaID,
+		Format:   schemaFormatFromConfluentType(resp.SchemaType),
+		Content:  resp.Schema,
+	}, nil
+}
+
+// CheckCompatibility checks newSchemaContent against the schema registered
+// at version under subject (POST
+// /compatibility/subjects/{subject}/versions/{version}). version is either
+// a Confluent-style integer version (as returned by ListSubjectVersions) or
+// "latest".
+func (s *SubjectClient) CheckCompatibility(ctx context.Context, subject, version, newSchemaContent string) (*CompatibilityResult, error) {
+	if err := s.client.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	body, err := marshalJSON(confluentSchemaPayload{Schema: newSchemaContent})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema payload: %w", err)
+	}
+
+	var resp confluentCompatibilityResponse
+	path := fmt.Sprintf("/compatibility/subjects/%s/versions/%s", url.PathEscape(subject), url.PathEscape(version))
+	if err := Retry(ctx, s.client.retryConfig, func() error {
+		return s.client.doRequest(ctx, "POST", path, body, &resp)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CompatibilityResult{IsCompatible: resp.IsCompatible}, nil
+}
+
+// SetCompatibility sets the compatibility mode enforced for subject (PUT
+// /config/{subject}).
+func (s *SubjectClient) SetCompatibility(ctx context.Context, subject string, mode CompatibilityMode) error {
+	if err := s.client.checkClosed(); err != nil {
+		return err
+	}
+
+	body, err := marshalJSON(confluentConfigUpdate{Compatibility: mode.String()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal config payload: %w", err)
+	}
+
+	path := fmt.Sprintf("/config/%s", url.PathEscape(subject))
+	return Retry(ctx, s.client.retryConfig, func() error {
+		return s.client.doRequest(ctx, "PUT", path, body, nil)
+	})
+}
@@ -0,0 +1,188 @@
+package schema_registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSubjectName(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy SubjectNamingStrategy
+		topic    string
+		isKey    bool
+		want     string
+		wantErr  bool
+	}{
+		{"record name", RecordNameStrategy, "", false, "telemetry.InferenceEvent", false},
+		{"topic name value", TopicNameStrategy, "events", false, "events-value", false},
+		{"topic name key", TopicNameStrategy, "events", true, "events-key", false},
+		{"topic record name", TopicRecordNameStrategy, "events", false, "events-telemetry.InferenceEvent", false},
+		{"topic name missing topic", TopicNameStrategy, "", false, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SubjectName(tt.strategy, tt.topic, "telemetry", "InferenceEvent", tt.isKey)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SubjectName failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SubjectName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubjectIdentity(t *testing.T) {
+	namespace, name, err := SubjectIdentity("telemetry.InferenceEvent")
+	if err != nil {
+		t.Fatalf("SubjectIdentity failed: %v", err)
+	}
+	if namespace != "telemetry" || name != "InferenceEvent" {
+		t.Errorf("got (%q, %q), want (telemetry, InferenceEvent)", namespace, name)
+	}
+
+	if _, _, err := SubjectIdentity("not-reversible"); err == nil {
+		t.Error("expected error for a non-RecordName subject, got nil")
+	}
+}
+
+func TestSubjectClientListSubjectsAndVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/subjects":
+			fmt.Fprint(w, `["telemetry.InferenceEvent"]`)
+		case "/subjects/telemetry.InferenceEvent/versions":
+			fmt.Fprint(w, `[1, 2, 3]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	subjectClient := NewSubjectClient(client, RecordNameStrategy)
+
+	subjects, err := subjectClient.ListSubjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListSubjects failed: %v", err)
+	}
+	if len(subjects) != 1 || subjects[0] != "telemetry.InferenceEvent" {
+		t.Errorf("unexpected subjects: %v", subjects)
+	}
+
+	versions, err := subjectClient.ListSubjectVersions(context.Background(), "telemetry.InferenceEvent")
+	if err != nil {
+		t.Fatalf("ListSubjectVersions failed: %v", err)
+	}
+	if len(versions) != 3 || versions[2] != 3 {
+		t.Errorf("unexpected versions: %v", versions)
+	}
+}
+
+func TestSubjectClientRegisterAndLookupByID(t *testing.T) {
+	const schemaID = "550e8400-e29b-41d4-a716-446655440000"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/subjects/telemetry.InferenceEvent/versions":
+			var payload confluentSchemaPayload
+			json.NewDecoder(r.Body).Decode(&payload)
+			if payload.SchemaType != "JSON" {
+				t.Errorf("unexpected schemaType: %q", payload.SchemaType)
+			}
+			fmt.Fprintf(w, `{"id":1,"schema_id":%q}`, schemaID)
+		case r.Method == http.MethodGet && r.URL.Path == "/schemas/ids/1":
+			fmt.Fprintf(w, `{"schema":"{}","schemaType":"JSON","schema_id":%q}`, schemaID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	subjectClient := NewSubjectClient(client, RecordNameStrategy)
+
+	id, err := subjectClient.RegisterVersion(context.Background(), "telemetry.InferenceEvent", "{}", SchemaFormatJSONSchema)
+	if err != nil {
+		t.Fatalf("RegisterVersion failed: %v", err)
+	}
+	if id != 1 {
+		t.Errorf("expected id 1, got %d", id)
+	}
+
+	if uuid, ok := subjectClient.SchemaUUID(id); !ok || uuid != schemaID {
+		t.Errorf("expected SchemaUUID(%d) = (%q, true), got (%q, %v)", id, schemaID, uuid, ok)
+	}
+
+	resp, err := subjectClient.SchemaByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("SchemaByID failed: %v", err)
+	}
+	if resp.SchemaID != schemaID {
+		t.Errorf("expected schema ID %q, got %q", schemaID, resp.SchemaID)
+	}
+}
+
+func TestSubjectClientCheckCompatibilityAndSetConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/compatibility/subjects/telemetry.InferenceEvent/versions/latest":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"is_compatible":true}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/config/telemetry.InferenceEvent":
+			var update confluentConfigUpdate
+			json.NewDecoder(r.Body).Decode(&update)
+			if update.Compatibility != "backward" {
+				t.Errorf("unexpected compatibility mode: %q", update.Compatibility)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	subjectClient := NewSubjectClient(client, RecordNameStrategy)
+
+	result, err := subjectClient.CheckCompatibility(context.Background(), "telemetry.InferenceEvent", "latest", "{}")
+	if err != nil {
+		t.Fatalf("CheckCompatibility failed: %v", err)
+	}
+	if !result.IsCompatible {
+		t.Error("expected compatible result")
+	}
+
+	if err := subjectClient.SetCompatibility(context.Background(), "telemetry.InferenceEvent", CompatibilityBackward); err != nil {
+		t.Fatalf("SetCompatibility failed: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package schema_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// sseEvent formats a SchemaEvent as a single SSE "data:" frame.
+func sseEvent(jsonPayload string) string {
+	return fmt.Sprintf("data: %s\n\n", jsonPayload)
+}
+
+func TestWatchSchemasDeliversEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, sseEvent(`{"type":"registered","schema_id":"abc","namespace":"ns","name":"Foo","version":"1.0.0","revision":1}`))
+		fmt.Fprint(w, sseEvent(`{"type":"new_version","schema_id":"abc","namespace":"ns","name":"Foo","version":"1.1.0","revision":2}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	events, err := client.WatchSchemas(ctx, WatchFilter{})
+	if err != nil {
+		t.Fatalf("WatchSchemas failed: %v", err)
+	}
+
+	var got []SchemaEvent
+	for evt := range events {
+		got = append(got, evt)
+		if len(got) == 2 {
+			cancel()
+		}
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(got))
+	}
+	if got[0].Type != EventRegistered || got[1].Type != EventNewVersion {
+		t.Errorf("unexpected event types: %v, %v", got[0].Type, got[1].Type)
+	}
+}
+
+func TestWatchSchemasReconnectsAfterServerError(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, sseEvent(`{"type":"registered","schema_id":"abc","namespace":"ns","name":"Foo","version":"1.0.0","revision":1}`))
+	}))
+	defer server.Close()
+
+	retryCfg := DefaultRetryConfig()
+	retryCfg.InitialBackoff = 10 * time.Millisecond
+	retryCfg.MaxBackoff = 20 * time.Millisecond
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL, RetryConfig: &retryCfg})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	events, err := client.WatchSchemas(ctx, WatchFilter{})
+	if err != nil {
+		t.Fatalf("WatchSchemas failed: %v", err)
+	}
+
+	select {
+	case evt, ok := <-events:
+		if !ok {
+			t.Fatal("events channel closed before delivering an event")
+		}
+		if evt.SchemaID != "abc" {
+			t.Errorf("unexpected schema ID: %s", evt.SchemaID)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event after reconnect")
+	}
+	cancel()
+
+	if atomic.LoadInt32(&attempts) < 2 {
+		t.Errorf("expected at least 2 connection attempts, got %d", attempts)
+	}
+}
+
+func TestWatchSchemasLargePayload(t *testing.T) {
+	largeDescription := strings.Repeat("x", 300*1024)
+	payload := fmt.Sprintf(`{"type":"registered","schema_id":"big","namespace":"ns","name":"Big","version":"1.0.0","revision":1,"schema":{"schema_id":"big","namespace":"ns","name":"Big","version":"1.0.0","content":"%s"}}`, largeDescription)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, sseEvent(payload))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	events, err := client.WatchSchemas(ctx, WatchFilter{})
+	if err != nil {
+		t.Fatalf("WatchSchemas failed: %v", err)
+	}
+
+	select {
+	case evt, ok := <-events:
+		if !ok {
+			t.Fatal("events channel closed without delivering the event")
+		}
+		if evt.Schema == nil || len(evt.Schema.Content) != 300*1024 {
+			t.Errorf("large payload was not delivered intact")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for large event")
+	}
+}